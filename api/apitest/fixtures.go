@@ -0,0 +1,293 @@
+package apitest
+
+import "net/http"
+
+// DefaultFixtures are the fixtures a new server starts with, keyed by method and path such as "GET /users".
+//
+// The bodies are based on the examples in the Twitch API reference.
+var DefaultFixtures = map[string]Fixture{
+	// Ads
+	fixtureKey(http.MethodGet, "/channels/ads"): {
+		Required: []string{"broadcaster_id"},
+		Body:     `{"data":[{"next_ad_at":"2023-08-01T23:08:18+00:00","last_ad_at":"2023-08-01T23:08:18+00:00","duration":60,"preroll_free_time":90,"snooze_count":1,"snooze_refresh_at":"2023-08-01T23:08:18+00:00"}]}`,
+	},
+	fixtureKey(http.MethodPost, "/channels/ads/schedule/snooze"): {
+		Required: []string{"broadcaster_id"},
+		Body:     `{"data":[{"snooze_count":1,"snooze_refresh_at":"2023-08-01T23:08:18+00:00","next_ad_at":"2023-08-01T23:08:18+00:00"}]}`,
+	},
+	fixtureKey(http.MethodPost, "/channels/commercial"): {
+		Body: `{"data":[{"length":60,"message":"","retry_after":480}]}`,
+	},
+
+	// Analytics
+	fixtureKey(http.MethodGet, "/analytics/extensions"): {
+		Body: `{"data":[{"extension_id":"efgh","URL":"https://twitch-piper-reports.s3-us-west-2.amazonaws.com/dynamic/LoL%20ADC.csv","type":"overview_v2","date_range":{"started_at":"2018-03-01T00:00:00Z","ended_at":"2018-06-01T00:00:00Z"}}],"pagination":{}}`,
+	},
+	fixtureKey(http.MethodGet, "/analytics/games"): {
+		Body: `{"data":[{"game_id":"493057","URL":"https://twitch-piper-reports.s3-us-west-2.amazonaws.com/games/66170/overview/15183.csv","type":"overview_v2","date_range":{"started_at":"2018-01-01T00:00:00Z","ended_at":"2018-03-01T00:00:00Z"}}],"pagination":{}}`,
+	},
+
+	// Bits
+	fixtureKey(http.MethodGet, "/bits/cheermotes"): {
+		Body: `{"data":[{"prefix":"Cheer","tiers":[{"min_bits":1,"id":"1","color":"#979797","images":{},"can_cheer":true,"show_in_bits_card":true}],"type":"global_first_party","order":1,"last_updated":"2018-05-22T00:06:04Z","is_charitable":false}]}`,
+	},
+	fixtureKey(http.MethodGet, "/bits/leaderboard"): {
+		Body: `{"data":[{"user_id":"158010205","user_login":"tundracowboy","user_name":"TundraCowboy","rank":1,"score":12543}],"date_range":{"started_at":"2018-02-05T08:00:00Z","ended_at":"2018-02-12T08:00:00Z"},"total":1}`,
+	},
+	fixtureKey(http.MethodGet, "/extensions/transactions"): {
+		Required: []string{"extension_id"},
+		Body:     `{"data":[{"id":"74c52265-e214-48a6-91b9-23b6014e8041","timestamp":"2019-01-28T04:15:53.325Z","broadcaster_id":"439964613","broadcaster_login":"chikuseuma","broadcaster_name":"chikuseuma","user_id":"424596340","user_login":"quotrok","user_name":"quotrok","product_type":"BITS_IN_EXTENSION","product_data":{"domain":"twitch.ext.uo6dggojyb8d6soh92zknwmi5ej1q2","sku":"testSku100","cost":{"amount":100,"type":"bits"},"inDevelopment":false,"displayName":"Test Product 100","broadcast":false}}],"pagination":{}}`,
+	},
+
+	// Channels
+	fixtureKey(http.MethodGet, "/channels"): {
+		Required: []string{"broadcaster_id"},
+		Body:     `{"data":[{"broadcaster_id":"141981764","broadcaster_login":"twitchdev","broadcaster_name":"TwitchDev","broadcaster_language":"en","game_id":"509670","game_name":"Science & Technology","title":"TwitchDev Monthly Update // May 6, 2021","delay":0,"tags":["DevsInTheKnow"],"content_classification_labels":["Gambling","DrugsIntoxication","MatureGame"],"is_branded_content":false}]}`,
+	},
+
+	// Channel Points
+	fixtureKey(http.MethodGet, "/channel_points/custom_rewards"): {
+		Required: []string{"broadcaster_id"},
+		Body:     customRewardBody,
+	},
+	fixtureKey(http.MethodPost, "/channel_points/custom_rewards"): {
+		Required: []string{"broadcaster_id"},
+		Body:     customRewardBody,
+	},
+	fixtureKey(http.MethodPatch, "/channel_points/custom_rewards"): {
+		Required: []string{"broadcaster_id", "id"},
+		Body:     customRewardBody,
+	},
+	fixtureKey(http.MethodDelete, "/channel_points/custom_rewards"): {
+		Required: []string{"broadcaster_id", "id"},
+		Status:   http.StatusNoContent,
+	},
+	fixtureKey(http.MethodGet, "/channel_points/custom_rewards/redemptions"): {
+		Required: []string{"broadcaster_id", "reward_id"},
+		Body:     redemptionBody,
+	},
+	fixtureKey(http.MethodPatch, "/channel_points/custom_rewards/redemptions"): {
+		Required: []string{"broadcaster_id", "reward_id", "id"},
+		Body:     redemptionBody,
+	},
+
+	// Chat
+	fixtureKey(http.MethodGet, "/chat/chatters"): {
+		Required: []string{"broadcaster_id", "moderator_id"},
+		Body:     `{"data":[{"user_id":"128393656","user_login":"smittysmithers","user_name":"smittysmithers"}],"pagination":{},"total":1}`,
+	},
+	fixtureKey(http.MethodGet, "/chat/emotes/global"): {
+		Body: `{"data":[{"id":"196892","name":"TwitchUnity","images":{"url_1x":"https://static-cdn.jtvnw.net/emoticons/v2/196892/static/light/1.0","url_2x":"https://static-cdn.jtvnw.net/emoticons/v2/196892/static/light/2.0","url_4x":"https://static-cdn.jtvnw.net/emoticons/v2/196892/static/light/3.0"},"format":["static"],"scale":["1.0","2.0","3.0"],"theme_mode":["light","dark"]}],"template":"https://static-cdn.jtvnw.net/emoticons/v2/{{id}}/{{format}}/{{theme_mode}}/{{scale}}"}`,
+	},
+	fixtureKey(http.MethodGet, "/chat/badges/global"): {
+		Body: `{"data":[{"set_id":"vip","versions":[{"id":"1","image_url_1x":"https://static-cdn.jtvnw.net/badges/v1/b817aba4-fad8-49e2-b88a-7cc744dfa6ec/1","image_url_2x":"https://static-cdn.jtvnw.net/badges/v1/b817aba4-fad8-49e2-b88a-7cc744dfa6ec/2","image_url_4x":"https://static-cdn.jtvnw.net/badges/v1/b817aba4-fad8-49e2-b88a-7cc744dfa6ec/3","title":"VIP","description":"VIP","click_action":"visit_url","click_url":"https://help.twitch.tv/customer/en/portal/articles/659115-twitch-chat-badges-guide"}]}]}`,
+	},
+
+	// Clips
+	fixtureKey(http.MethodGet, "/clips"): {
+		OneOf: []string{"id", "broadcaster_id", "game_id"},
+		Body:  `{"data":[{"id":"AwkwardHelplessSalamanderSwiftRage","url":"https://clips.twitch.tv/AwkwardHelplessSalamanderSwiftRage","embed_url":"https://clips.twitch.tv/embed?clip=AwkwardHelplessSalamanderSwiftRage","broadcaster_id":"67955580","broadcaster_name":"ChewieMelodies","creator_id":"53834192","creator_name":"BlackNova03","video_id":"205586603","game_id":"488191","language":"en","title":"babymetal","view_count":10,"created_at":"2017-11-30T22:34:18Z","thumbnail_url":"https://clips-media-assets.twitch.tv/157589949-preview-480x272.jpg","duration":60,"vod_offset":480,"is_featured":false}],"pagination":{}}`,
+	},
+
+	// EventSub
+	fixtureKey(http.MethodGet, "/eventsub/subscriptions"): {
+		Body: `{"total":1,"data":[{"id":"26b1c993-bfcf-44d9-b876-379dacafe75a","status":"enabled","type":"stream.online","version":"1","condition":{"broadcaster_user_id":"1234"},"created_at":"2020-11-10T20:08:33.12345678Z","transport":{"method":"webhook","callback":"https://this-is-a-callback.com"},"cost":1}],"total_cost":1,"max_total_cost":10000,"pagination":{}}`,
+	},
+	fixtureKey(http.MethodPost, "/eventsub/subscriptions"): {
+		Status: http.StatusAccepted,
+		Body:   `{"data":[{"id":"26b1c993-bfcf-44d9-b876-379dacafe75a","status":"webhook_callback_verification_pending","type":"stream.online","version":"1","condition":{"broadcaster_user_id":"1234"},"created_at":"2020-11-10T14:32:18.730260295Z","transport":{"method":"webhook","callback":"https://this-is-a-callback.com"},"cost":1}],"total":1,"total_cost":1,"max_total_cost":10000}`,
+	},
+	fixtureKey(http.MethodDelete, "/eventsub/subscriptions"): {
+		Required: []string{"id"},
+		Status:   http.StatusNoContent,
+	},
+	fixtureKey(http.MethodGet, "/eventsub/conduits"): {
+		Body: `{"data":[{"id":"26b1c993-bfcf-44d9-b876-379dacafe75a","shard_count":15}]}`,
+	},
+	fixtureKey(http.MethodPost, "/eventsub/conduits"): {
+		Body: `{"data":[{"id":"26b1c993-bfcf-44d9-b876-379dacafe75a","shard_count":5}]}`,
+	},
+	fixtureKey(http.MethodPatch, "/eventsub/conduits"): {
+		Body: `{"data":[{"id":"26b1c993-bfcf-44d9-b876-379dacafe75a","shard_count":5}]}`,
+	},
+	fixtureKey(http.MethodDelete, "/eventsub/conduits"): {
+		Required: []string{"id"},
+		Status:   http.StatusNoContent,
+	},
+	fixtureKey(http.MethodGet, "/eventsub/conduits/shards"): {
+		Required: []string{"conduit_id"},
+		Body:     `{"data":[{"id":"0","status":"enabled","transport":{"method":"webhook","callback":"https://this-is-a-callback.com"}}],"pagination":{}}`,
+	},
+	fixtureKey(http.MethodPatch, "/eventsub/conduits/shards"): {
+		Status: http.StatusAccepted,
+		Body:   `{"data":[{"id":"0","status":"enabled","transport":{"method":"webhook","callback":"https://this-is-a-callback.com"}}],"errors":[]}`,
+	},
+
+	// Games
+	fixtureKey(http.MethodGet, "/games"): {
+		OneOf: []string{"id", "name", "igdb_id"},
+		Body:  `{"data":[{"id":"33214","name":"Fortnite","box_art_url":"https://static-cdn.jtvnw.net/ttv-boxart/33214-{width}x{height}.jpg","igdb_id":"1905"}]}`,
+	},
+	fixtureKey(http.MethodGet, "/games/top"): {
+		Body: `{"data":[{"id":"493057","name":"PUBG: BATTLEGROUNDS","box_art_url":"https://static-cdn.jtvnw.net/ttv-boxart/493057-{width}x{height}.jpg","igdb_id":"27789"}],"pagination":{}}`,
+	},
+
+	// Moderation
+	fixtureKey(http.MethodPost, "/moderation/bans"): {
+		Required: []string{"broadcaster_id", "moderator_id"},
+		Body:     `{"data":[{"broadcaster_id":"1234","moderator_id":"5678","user_id":"9876","created_at":"2021-09-28T19:27:31Z","end_time":"2021-09-28T19:22:31Z"}]}`,
+	},
+	fixtureKey(http.MethodDelete, "/moderation/bans"): {
+		Required: []string{"broadcaster_id", "moderator_id", "user_id"},
+		Status:   http.StatusNoContent,
+	},
+	fixtureKey(http.MethodDelete, "/moderation/chat"): {
+		Required: []string{"broadcaster_id", "moderator_id"},
+		Status:   http.StatusNoContent,
+	},
+
+	// Polls
+	fixtureKey(http.MethodGet, "/polls"): {
+		Required: []string{"broadcaster_id"},
+		Body:     `{"data":[` + pollBody + `],"pagination":{}}`,
+	},
+	fixtureKey(http.MethodPost, "/polls"): {
+		Body: `{"data":[` + pollBody + `]}`,
+	},
+	fixtureKey(http.MethodPatch, "/polls"): {
+		Body: `{"data":[` + pollBody + `]}`,
+	},
+
+	// Predictions
+	fixtureKey(http.MethodGet, "/predictions"): {
+		Required: []string{"broadcaster_id"},
+		Body:     `{"data":[` + predictionBody + `],"pagination":{}}`,
+	},
+	fixtureKey(http.MethodPost, "/predictions"): {
+		Body: `{"data":[` + predictionBody + `]}`,
+	},
+	fixtureKey(http.MethodPatch, "/predictions"): {
+		Body: `{"data":[` + predictionBody + `]}`,
+	},
+
+	// Raids
+	fixtureKey(http.MethodPost, "/raids"): {
+		Required: []string{"from_broadcaster_id", "to_broadcaster_id"},
+		Body:     `{"data":[{"created_at":"2022-02-18T07:20:50.52Z","is_mature":false}]}`,
+	},
+	fixtureKey(http.MethodDelete, "/raids"): {
+		Required: []string{"broadcaster_id"},
+		Status:   http.StatusNoContent,
+	},
+
+	// Schedule
+	fixtureKey(http.MethodGet, "/schedule"): {
+		Required: []string{"broadcaster_id"},
+		Body:     `{"data":` + scheduleBody + `,"pagination":{}}`,
+	},
+	fixtureKey(http.MethodGet, "/schedule/icalendar"): {
+		Required:    []string{"broadcaster_id"},
+		NoAuth:      true,
+		ContentType: "text/calendar",
+		Body:        icalendarBody,
+	},
+	fixtureKey(http.MethodPatch, "/schedule/settings"): {
+		Required: []string{"broadcaster_id"},
+		Status:   http.StatusNoContent,
+	},
+	fixtureKey(http.MethodPost, "/schedule/segment"): {
+		Required: []string{"broadcaster_id"},
+		Body:     `{"data":` + scheduleBody + `}`,
+	},
+	fixtureKey(http.MethodPatch, "/schedule/segment"): {
+		Required: []string{"broadcaster_id", "id"},
+		Body:     `{"data":` + scheduleBody + `}`,
+	},
+	fixtureKey(http.MethodDelete, "/schedule/segment"): {
+		Required: []string{"broadcaster_id", "id"},
+		Status:   http.StatusNoContent,
+	},
+
+	// Search
+	fixtureKey(http.MethodGet, "/search/categories"): {
+		Required: []string{"query"},
+		Body:     `{"data":[{"id":"33214","name":"Fortnite","box_art_url":"https://static-cdn.jtvnw.net/ttv-boxart/33214-52x72.jpg"}],"pagination":{}}`,
+	},
+	fixtureKey(http.MethodGet, "/search/channels"): {
+		Required: []string{"query"},
+		Body:     `{"data":[{"broadcaster_language":"en","broadcaster_login":"loserfruit","display_name":"Loserfruit","game_id":"498000","game_name":"House Flipper","id":"41245072","is_live":false,"tags":[],"thumbnail_url":"https://static-cdn.jtvnw.net/jtv_user_pictures/fd17325a-7dc2-46c6-8617-e90ec259501c-profile_image-300x300.png","title":"loserfruit","started_at":""}],"pagination":{}}`,
+	},
+
+	// Streams
+	fixtureKey(http.MethodGet, "/streams"): {
+		Body: `{"data":[{"id":"40952121085","user_id":"101051819","user_login":"afro","user_name":"Afro","game_id":"32982","game_name":"Grand Theft Auto V","type":"live","title":"Jacob: Digital Den Laptops & Routers | NoPixel | !MAINGEAR !FCF","tags":["English"],"viewer_count":1490,"started_at":"2021-03-31T20:57:26Z","language":"en","thumbnail_url":"https://static-cdn.jtvnw.net/previews-ttv/live_user_afro-{width}x{height}.jpg","is_mature":false}],"pagination":{}}`,
+	},
+
+	// Subscriptions
+	fixtureKey(http.MethodGet, "/subscriptions"): {
+		Required: []string{"broadcaster_id"},
+		Body:     `{"data":[{"broadcaster_id":"141981764","broadcaster_login":"twitchdev","broadcaster_name":"TwitchDev","gifter_id":"12826","gifter_login":"twitch","gifter_name":"Twitch","is_gift":true,"tier":"1000","plan_name":"Channel Subscription (twitchdev)","user_id":"527115020","user_name":"twitchgaming","user_login":"twitchgaming"}],"pagination":{},"total":13,"points":13}`,
+	},
+	fixtureKey(http.MethodGet, "/subscriptions/user"): {
+		Required: []string{"broadcaster_id", "user_id"},
+		Body:     `{"data":[{"broadcaster_id":"141981764","broadcaster_name":"TwitchDev","broadcaster_login":"twitchdev","is_gift":false,"tier":"1000"}]}`,
+	},
+
+	// Teams
+	fixtureKey(http.MethodGet, "/teams"): {
+		OneOf: []string{"name", "id"},
+		Body:  `{"data":[{"users":[{"user_id":"278217731","user_name":"mastermndio","user_login":"mastermndio"}],"background_image_url":null,"banner":null,"created_at":"2019-02-11 12:09:22","updated_at":"2020-11-18 15:56:41","info":"<p>Live Coders</p>","thumbnail_url":"https://static-cdn.jtvnw.net/jtv_user_pictures/livecoders.png","team_name":"livecoders","team_display_name":"Live Coders","id":"6358"}]}`,
+	},
+	fixtureKey(http.MethodGet, "/teams/channel"): {
+		Required: []string{"broadcaster_id"},
+		Body:     `{"data":[{"broadcaster_id":"96909659","broadcaster_name":"CSharpFritz","broadcaster_login":"csharpfritz","background_image_url":null,"banner":null,"created_at":"2019-02-11 12:09:22","updated_at":"2020-11-18 15:56:41","info":"<p>Live Coders</p>","thumbnail_url":"https://static-cdn.jtvnw.net/jtv_user_pictures/livecoders.png","team_name":"livecoders","team_display_name":"Live Coders","id":"6358"}]}`,
+	},
+
+	// Users
+	fixtureKey(http.MethodGet, "/users"): {
+		Body: `{"data":[{"id":"141981764","login":"twitchdev","display_name":"TwitchDev","type":"","broadcaster_type":"partner","description":"Supporting third-party developers building Twitch integrations from chatbots to game integrations.","profile_image_url":"https://static-cdn.jtvnw.net/jtv_user_pictures/8a6381c7-d0c0-4576-b179-38bd5ce1d6af-profile_image-300x300.png","offline_image_url":"https://static-cdn.jtvnw.net/jtv_user_pictures/3f13ab61-ec78-4fe6-8481-8682cb3b0ac2-channel_offline_image-1920x1080.png","created_at":"2016-12-14T20:32:28Z"}]}`,
+	},
+
+	// Videos
+	fixtureKey(http.MethodGet, "/videos"): {
+		OneOf: []string{"id", "user_id", "game_id"},
+		Body:  `{"data":[{"id":"335921245","stream_id":null,"user_id":"141981764","user_login":"twitchdev","user_name":"TwitchDev","title":"Twitch Developers 101","description":"Welcome to Twitch development!","created_at":"2018-11-14T21:30:18Z","published_at":"2018-11-14T22:04:30Z","url":"https://www.twitch.tv/videos/335921245","thumbnail_url":"https://static-cdn.jtvnw.net/cf_vods/d2nvs31859zcd8/twitchdev/335921245/ce0f3a7f-57a3-4152-bc06-0c6610189fb3/thumb/index-0000000000-%{width}x%{height}.jpg","viewable":"public","view_count":1863062,"language":"en","type":"upload","duration":"3m21s","muted_segments":null}],"pagination":{}}`,
+	},
+	fixtureKey(http.MethodDelete, "/videos"): {
+		Required: []string{"id"},
+		Body:     `{"data":["1234","9876"]}`,
+	},
+
+	// Whispers
+	fixtureKey(http.MethodPost, "/whispers"): {
+		Required: []string{"from_user_id", "to_user_id"},
+		Status:   http.StatusNoContent,
+	},
+}
+
+const customRewardBody = `{"data":[{"broadcaster_name":"torpedo09","broadcaster_login":"torpedo09","broadcaster_id":"274637212","id":"92af127c-7326-4483-a52b-b0da0be61c01","image":null,"background_color":"#00E5CB","is_enabled":true,"cost":50000,"title":"game analysis","prompt":"","is_user_input_required":false,"max_per_stream_setting":{"is_enabled":false,"max_per_stream":0},"max_per_user_per_stream_setting":{"is_enabled":false,"max_per_user_per_stream":0},"global_cooldown_setting":{"is_enabled":false,"global_cooldown_seconds":0},"is_paused":false,"is_in_stock":true,"default_image":{"url_1x":"https://static-cdn.jtvnw.net/custom-reward-images/default-1.png","url_2x":"https://static-cdn.jtvnw.net/custom-reward-images/default-2.png","url_4x":"https://static-cdn.jtvnw.net/custom-reward-images/default-4.png"},"should_redemptions_skip_request_queue":false,"redemptions_redeemed_current_stream":null,"cooldown_expires_at":null}]}`
+
+const redemptionBody = `{"data":[{"broadcaster_name":"torpedo09","broadcaster_login":"torpedo09","broadcaster_id":"274637212","id":"17fa2df1-ad76-4804-bfa5-a40ef63efe63","user_login":"torpedo09","user_id":"274637212","user_name":"torpedo09","user_input":"","status":"CANCELED","redeemed_at":"2020-07-01T18:37:32Z","reward":{"id":"92af127c-7326-4483-a52b-b0da0be61c01","title":"game analysis","prompt":"","cost":50000}}],"pagination":{}}`
+
+const pollBody = `{"id":"ed961efd-8a3f-4cf5-a9d0-e616c590cd2a","broadcaster_id":"141981764","broadcaster_name":"TwitchDev","broadcaster_login":"twitchdev","title":"Heads or Tails?","choices":[{"id":"4c123012-1351-4f33-84b7-43856e7a0f47","title":"Heads","votes":0,"channel_points_votes":0,"bits_votes":0},{"id":"279087e3-54a7-467e-bcd0-c1393fcea4f0","title":"Tails","votes":0,"channel_points_votes":0,"bits_votes":0}],"bits_voting_enabled":false,"bits_per_vote":0,"channel_points_voting_enabled":false,"channel_points_per_vote":0,"status":"ACTIVE","duration":1800,"started_at":"2021-03-19T06:08:33.871278372Z"}`
+
+const predictionBody = `{"id":"bc637af0-7766-4525-9308-4112f4cbf178","broadcaster_id":"141981764","broadcaster_name":"TwitchDev","broadcaster_login":"twitchdev","title":"Any leeks in the stream?","winning_outcome_id":null,"outcomes":[{"id":"73085848-a94d-4040-9d21-2cb7a89374b7","title":"Yes, give it time.","users":0,"channel_points":0,"top_predictors":null,"color":"BLUE"},{"id":"906b70ba-1f12-47ea-9e95-e5f93d20e9cc","title":"Definitely not.","users":0,"channel_points":0,"top_predictors":null,"color":"PINK"}],"prediction_window":120,"status":"ACTIVE","created_at":"2021-04-28T16:03:06.320848689Z","ended_at":null,"locked_at":null}`
+
+const scheduleBody = `{"segments":[{"id":"eyJzZWdtZW50SUQiOiJlNGFjYzcyNC0zNzFmLTQwMmMtODFjYS0yM2FkYTc5NzU5ZDQiLCJpc29ZZWFyIjoyMDIxLCJpc29XZWVrIjoyNn0=","start_time":"2021-07-01T18:00:00Z","end_time":"2021-07-01T19:00:00Z","title":"TwitchDev Monthly Update // July 1, 2021","canceled_until":null,"category":{"id":"509670","name":"Science & Technology"},"is_recurring":false}],"broadcaster_id":"141981764","broadcaster_name":"TwitchDev","broadcaster_login":"twitchdev","vacation":null}`
+
+const icalendarBody = "BEGIN:VCALENDAR\r\n" +
+	"PRODID:-//twitch.tv//StreamSchedule//1.0\r\n" +
+	"VERSION:2.0\r\n" +
+	"CALSCALE:GREGORIAN\r\n" +
+	"REFRESH-INTERVAL;VALUE=DURATION:PT1H\r\n" +
+	"NAME:TwitchDev\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:e4acc724-371f-402c-81ca-23ada79759d4\r\n" +
+	"DTSTAMP:20210323T040131Z\r\n" +
+	"DTSTART;TZID=/America/New_York:20210701T140000\r\n" +
+	"DTEND;TZID=/America/New_York:20210701T150000\r\n" +
+	"SUMMARY:TwitchDev Monthly Update // July 1, 2021\r\n" +
+	"DESCRIPTION:Science & Technology.\r\n" +
+	"CATEGORIES:Science & Technology\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
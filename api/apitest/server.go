@@ -0,0 +1,303 @@
+// Package apitest provides a fake Twitch API server for testing code that uses the api package.
+//
+//	server := apitest.NewServer()
+//	defer server.Close()
+//
+//	client := server.Client()
+//	res, err := client.Users.List().Login([]string{"twitchdev"}).Do(ctx)
+package apitest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/adeithe/go-twitch/api"
+)
+
+// Default credentials accepted by the server
+const (
+	ClientID     = "apitest-client-id"
+	ClientSecret = "apitest-client-secret"
+	AccessToken  = "apitest-access-token"
+)
+
+// Fixture is the canned response for an endpoint of the fake API.
+type Fixture struct {
+	Status      int      // The status code of the response. Default: 200
+	Body        string   // The body of the response.
+	ContentType string   // The content type of the body. Default: application/json
+	Required    []string // Query parameters that must be set.
+	OneOf       []string // Query parameters of which at least one must be set.
+	NoAuth      bool     // Allow requests without an access token.
+}
+
+// Request is a request received by the server.
+type Request struct {
+	Method string
+	Path   string
+	Query  map[string][]string
+	Header http.Header
+	Body   []byte
+}
+
+type injectedError struct {
+	status int
+	count  int
+}
+
+// Server is a fake Twitch API server that serves canned fixtures for each endpoint.
+//
+// Every request must include the client ID and one of the accepted access tokens. Requests that are missing
+// required query parameters are rejected the same way as the Twitch API would.
+type Server struct {
+	*httptest.Server
+
+	clientID string
+	tokens   map[string]bool
+	fixtures map[string]Fixture
+	errors   map[string]*injectedError
+	requests []Request
+
+	mx sync.Mutex
+}
+
+// NewServer starts a fake Twitch API server with the default fixtures.
+//
+// The server must be closed with Close when it is no longer needed.
+func NewServer() *Server {
+	s := &Server{
+		clientID: ClientID,
+		tokens:   map[string]bool{AccessToken: true},
+		fixtures: make(map[string]Fixture),
+		errors:   make(map[string]*injectedError),
+	}
+	for key, fixture := range DefaultFixtures {
+		s.fixtures[key] = fixture
+	}
+	s.Server = httptest.NewServer(s)
+	return s
+}
+
+// BaseURL returns the base URL of the fake Twitch API.
+func (s *Server) BaseURL() string {
+	return s.URL + "/helix"
+}
+
+// AuthURL returns the base URL of the fake Twitch authentication server.
+func (s *Server) AuthURL() string {
+	return s.URL + "/oauth2"
+}
+
+// Client creates an API client that sends requests to the server using the default access token.
+func (s *Server) Client(opts ...api.ClientOption) *api.Client {
+	defaults := []api.ClientOption{
+		api.WithBaseURL(s.BaseURL()),
+		api.WithAuthURL(s.AuthURL()),
+		api.WithHTTPClient(s.Server.Client()),
+		api.WithDefaultBearerToken(AccessToken),
+	}
+	return api.New(s.clientID, append(defaults, opts...)...)
+}
+
+// AddToken adds an access token that the server accepts.
+func (s *Server) AddToken(token string) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	s.tokens[token] = true
+}
+
+// RevokeToken removes an access token so that requests using it fail with 401 Invalid OAuth token.
+func (s *Server) RevokeToken(token string) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	delete(s.tokens, token)
+}
+
+// SetFixture sets the response for the endpoint with the provided method and path, such as "/users".
+func (s *Server) SetFixture(method, path string, fixture Fixture) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	s.fixtures[fixtureKey(method, path)] = fixture
+}
+
+// InjectError responds to the next count requests to the endpoint with the provided status code.
+//
+// A 429 Too Many Requests response includes rate limit headers with a reset time of one second later.
+func (s *Server) InjectError(method, path string, status, count int) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	s.errors[fixtureKey(method, path)] = &injectedError{status, count}
+}
+
+// Requests returns every request received by the server.
+func (s *Server) Requests() []Request {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// ServeHTTP handles a request to the fake Twitch API.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "")
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	path := strings.TrimPrefix(r.URL.Path, "/helix")
+	s.mx.Lock()
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   path,
+		Query:  r.URL.Query(),
+		Header: r.Header.Clone(),
+		Body:   body,
+	})
+	s.mx.Unlock()
+
+	if strings.HasPrefix(r.URL.Path, "/oauth2/") {
+		s.serveAuth(w, r)
+		return
+	}
+
+	key := fixtureKey(r.Method, path)
+	s.mx.Lock()
+	fixture, ok := s.fixtures[key]
+	injected := s.errors[key]
+	var status int
+	if injected != nil && injected.count > 0 {
+		injected.count--
+		status = injected.status
+	}
+	validToken := s.tokens[strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")]
+	s.mx.Unlock()
+
+	if !ok {
+		writeError(w, http.StatusNotFound, "")
+		return
+	}
+	if r.Header.Get("Client-Id") != s.clientID {
+		writeError(w, http.StatusUnauthorized, "Client ID and OAuth token do not match")
+		return
+	}
+	if !fixture.NoAuth && !validToken {
+		writeError(w, http.StatusUnauthorized, "Invalid OAuth token")
+		return
+	}
+	for _, param := range fixture.Required {
+		if !r.URL.Query().Has(param) {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Missing required parameter %q", param))
+			return
+		}
+	}
+	if len(fixture.OneOf) > 0 && !hasOneOf(r, fixture.OneOf) {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("One of %s must be specified", strings.Join(fixture.OneOf, ", ")))
+		return
+	}
+
+	setRateLimitHeaders(w, status == http.StatusTooManyRequests)
+	if status != 0 {
+		writeError(w, status, "")
+		return
+	}
+	if fixture.Status == 0 {
+		fixture.Status = http.StatusOK
+	}
+	if fixture.ContentType == "" {
+		fixture.ContentType = "application/json"
+	}
+	if len(fixture.Body) > 0 {
+		w.Header().Set("Content-Type", fixture.ContentType)
+	}
+	w.WriteHeader(fixture.Status)
+	w.Write([]byte(fixture.Body))
+}
+
+// serveAuth handles a request to the fake Twitch authentication server.
+func (s *Server) serveAuth(w http.ResponseWriter, r *http.Request) {
+	switch strings.TrimPrefix(r.URL.Path, "/oauth2") {
+	case "/token":
+		if err := r.ParseForm(); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid form")
+			return
+		}
+		if r.PostForm.Get("client_id") != s.clientID || r.PostForm.Get("client_secret") != ClientSecret {
+			writeError(w, http.StatusForbidden, "invalid client secret")
+			return
+		}
+		s.AddToken(AccessToken)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"access_token": AccessToken,
+			"expires_in":   5011271,
+			"token_type":   "bearer",
+		})
+	case "/validate":
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "OAuth ")
+		s.mx.Lock()
+		valid := s.tokens[token]
+		s.mx.Unlock()
+		if !valid {
+			writeError(w, http.StatusUnauthorized, "invalid access token")
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"client_id":  s.clientID,
+			"scopes":     []string{},
+			"expires_in": 5011271,
+		})
+	case "/revoke":
+		if err := r.ParseForm(); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid form")
+			return
+		}
+		s.RevokeToken(r.PostForm.Get("token"))
+		w.WriteHeader(http.StatusOK)
+	default:
+		writeError(w, http.StatusNotFound, "")
+	}
+}
+
+func fixtureKey(method, path string) string {
+	return method + " /" + strings.TrimPrefix(path, "/")
+}
+
+func hasOneOf(r *http.Request, params []string) bool {
+	for _, param := range params {
+		if r.URL.Query().Has(param) {
+			return true
+		}
+	}
+	return false
+}
+
+func setRateLimitHeaders(w http.ResponseWriter, exhausted bool) {
+	remaining, reset := 799, time.Now().Add(time.Minute)
+	if exhausted {
+		remaining, reset = 0, time.Now().Add(time.Second)
+	}
+	w.Header().Set(api.HeaderRatelimitLimit, "800")
+	w.Header().Set(api.HeaderRatelimitRemaining, strconv.Itoa(remaining))
+	w.Header().Set(api.HeaderRatelimitReset, strconv.FormatInt(reset.Unix(), 10))
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"error":   http.StatusText(status),
+		"status":  status,
+		"message": message,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,84 @@
+package apitest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/adeithe/go-twitch/api"
+	"github.com/adeithe/go-twitch/api/apitest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_Fixtures(t *testing.T) {
+	server := apitest.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+	res, err := client.Users.List().Login([]string{"twitchdev"}).Do(context.Background())
+	require.NoError(t, err)
+	require.Len(t, res.Data, 1)
+	assert.Equal(t, "141981764", res.Data[0].ID)
+
+	requests := server.Requests()
+	require.Len(t, requests, 1)
+	assert.Equal(t, "/users", requests[0].Path)
+	assert.Equal(t, "Bearer "+apitest.AccessToken, requests[0].Header.Get("Authorization"))
+	assert.Equal(t, []string{"twitchdev"}, requests[0].Query["login"])
+}
+
+func TestServer_SetFixture(t *testing.T) {
+	server := apitest.NewServer()
+	defer server.Close()
+
+	server.SetFixture(http.MethodGet, "/users", apitest.Fixture{Body: `{"data":[]}`})
+	res, err := server.Client().Users.List().ID([]string{"1234"}).Do(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, res.Data)
+}
+
+func TestServer_Validation(t *testing.T) {
+	server := apitest.NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	client := server.Client()
+
+	_, err := client.Clips.List().Do(ctx)
+	assert.True(t, errors.Is(err, api.ErrBadRequest))
+
+	_, err = client.Users.List().Do(ctx, api.WithBearerToken("invalid"))
+	assert.True(t, errors.Is(err, api.ErrUnauthorized))
+
+	_, err = api.New("invalid", api.WithBaseURL(server.BaseURL())).Users.List().Do(ctx, api.WithBearerToken(apitest.AccessToken))
+	assert.True(t, errors.Is(err, api.ErrUnauthorized))
+}
+
+func TestServer_AppToken(t *testing.T) {
+	server := apitest.NewServer()
+	defer server.Close()
+
+	client := server.Client(api.WithClientSecret(apitest.ClientSecret))
+	_, err := client.Users.List().Login([]string{"twitchdev"}).Do(context.Background(), api.WithTokenSource(api.NewAppTokenSource(client)))
+	require.NoError(t, err)
+}
+
+func TestServer_InjectError(t *testing.T) {
+	server := apitest.NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	client := server.Client()
+
+	server.InjectError(http.MethodPost, "/whispers", http.StatusServiceUnavailable, 1)
+	err := client.Whispers.Insert("1234", "5678").Message("hello").Do(ctx)
+	assert.True(t, errors.Is(err, api.ErrServerError))
+	assert.NoError(t, client.Whispers.Insert("1234", "5678").Message("hello").Do(ctx))
+
+	server.InjectError(http.MethodGet, "/users", http.StatusTooManyRequests, 1)
+	_, err = client.Users.List().Login([]string{"twitchdev"}).Do(ctx)
+	require.NoError(t, err)
+	assert.Len(t, server.Requests(), 4)
+}
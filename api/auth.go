@@ -0,0 +1,286 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AuthURL is the base URL for the Twitch authentication server.
+const AuthURL = "https://id.twitch.tv/oauth2"
+
+// ErrNoRefreshToken returned when a token has expired and can not be refreshed.
+var ErrNoRefreshToken = errors.New("twitchapi: token has expired and has no refresh token")
+
+// tokenExpiryDelta is how long before a token expires that it will be refreshed.
+const tokenExpiryDelta = time.Minute * 5
+
+// Token is an OAuth access token issued by Twitch.
+type Token struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	TokenType    string    `json:"token_type,omitempty"`
+	Scopes       []string  `json:"scope,omitempty"`
+	ExpiresIn    int       `json:"expires_in,omitempty"`
+	Expiry       time.Time `json:"-"` // Zero if the token does not expire or the expiry is unknown.
+}
+
+// Valid returns true if the token is set and will not expire soon.
+func (t *Token) Valid() bool {
+	if t == nil || t.AccessToken == "" {
+		return false
+	}
+	return t.Expiry.IsZero() || time.Until(t.Expiry) > tokenExpiryDelta
+}
+
+// TokenSource provides access tokens for API requests.
+type TokenSource interface {
+	// Token returns a token that is valid for use, refreshing it if needed.
+	Token(ctx context.Context) (*Token, error)
+}
+
+// TokenInvalidator is implemented by token sources that can discard a token rejected by the API.
+//
+// When a request fails with 401 Invalid OAuth token, the token is invalidated and the request is sent once more
+// with a new token from the same source.
+type TokenInvalidator interface {
+	// Invalidate discards the provided token if it is still the current token of the source.
+	Invalidate(token *Token)
+}
+
+type tokenSourceKey struct{}
+
+type staticTokenSource struct {
+	token *Token
+}
+
+// StaticTokenSource returns a TokenSource that always returns the provided token.
+func StaticTokenSource(token *Token) TokenSource {
+	return staticTokenSource{token}
+}
+
+func (s staticTokenSource) Token(ctx context.Context) (*Token, error) {
+	return s.token, nil
+}
+
+// AuthResource is the API resource for requesting tokens from the Twitch authentication server.
+type AuthResource struct {
+	client *Client
+}
+
+// NewAuthResource creates a new AuthResource.
+func NewAuthResource(client *Client) *AuthResource {
+	return &AuthResource{client}
+}
+
+// AuthTokenCall is the API call for requesting an access token.
+type AuthTokenCall struct {
+	resource *AuthResource
+	form     url.Values
+}
+
+// ClientCredentials creates a request for an app access token using the client ID and secret.
+//
+// The client secret must be set with WithClientSecret.
+func (r *AuthResource) ClientCredentials() *AuthTokenCall {
+	form := url.Values{}
+	form.Set("client_id", r.client.clientID)
+	form.Set("client_secret", r.client.clientSecret)
+	form.Set("grant_type", "client_credentials")
+	return &AuthTokenCall{resource: r, form: form}
+}
+
+// RefreshToken creates a request for a new user access token using a refresh token.
+//
+// The client secret must be set with WithClientSecret unless the app is registered as a public client.
+func (r *AuthResource) RefreshToken(refreshToken string) *AuthTokenCall {
+	form := url.Values{}
+	form.Set("client_id", r.client.clientID)
+	if r.client.clientSecret != "" {
+		form.Set("client_secret", r.client.clientSecret)
+	}
+	form.Set("grant_type", "refresh_token")
+	form.Set("refresh_token", refreshToken)
+	return &AuthTokenCall{resource: r, form: form}
+}
+
+// Do executes the request.
+func (c *AuthTokenCall) Do(ctx context.Context) (*Token, error) {
+	res, err := c.resource.client.doAuthRequest(ctx, "AuthTokenCall", http.MethodPost, "/token", c.form)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	var token Token
+	if err := decodeAuthResponse(res, &token); err != nil {
+		return nil, err
+	}
+	if token.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+	return &token, nil
+}
+
+// AppTokenSource provides app access tokens using the client credentials grant flow.
+//
+// Tokens are cached and a new token is requested shortly before the current token expires.
+type AppTokenSource struct {
+	client *Client
+	token  *Token
+	mx     sync.Mutex
+}
+
+var _ TokenSource = &AppTokenSource{}
+var _ TokenInvalidator = &AppTokenSource{}
+
+// NewAppTokenSource creates a new AppTokenSource for the provided client.
+//
+// The client secret must be set with WithClientSecret.
+func NewAppTokenSource(client *Client) *AppTokenSource {
+	return &AppTokenSource{client: client}
+}
+
+// Token returns the cached app access token, requesting a new one if it is missing or about to expire.
+func (s *AppTokenSource) Token(ctx context.Context) (*Token, error) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	if s.token.Valid() {
+		return s.token, nil
+	}
+	token, err := s.client.Auth.ClientCredentials().Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.token = token
+	return token, nil
+}
+
+// Invalidate discards the cached app access token so that a new one is requested.
+func (s *AppTokenSource) Invalidate(token *Token) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	if s.token == token {
+		s.token = nil
+	}
+}
+
+// RefreshTokenSource provides user access tokens using the refresh token grant flow.
+//
+// The token is refreshed shortly before it expires. Tokens without a known expiry are used as is.
+type RefreshTokenSource struct {
+	client    *Client
+	token     *Token
+	onRefresh func(*Token) error
+	unsaved   bool // True if onRefresh has not yet succeeded with the current token.
+	mx        sync.Mutex
+}
+
+var _ TokenSource = &RefreshTokenSource{}
+var _ TokenInvalidator = &RefreshTokenSource{}
+
+// NewRefreshTokenSource creates a new RefreshTokenSource for the provided client and user access token.
+//
+// The onRefresh function is called with every new token pair so that it can be persisted and may be nil.
+// Refresh tokens for public clients can only be used once, so the new pair should always be stored.
+//
+//	source := api.NewRefreshTokenSource(client, token, func(token *api.Token) error {
+//		return db.SaveToken(broadcasterID, token)
+//	})
+//	data, err := client.Whispers.Insert("123", "456").Message("Hello").Do(ctx, api.WithTokenSource(source))
+func NewRefreshTokenSource(client *Client, token *Token, onRefresh func(*Token) error) *RefreshTokenSource {
+	return &RefreshTokenSource{client: client, token: token, onRefresh: onRefresh}
+}
+
+// Token returns the user access token, refreshing it if it is about to expire.
+//
+// If the onRefresh function returns an error, the new token is still returned and used for future requests, and
+// onRefresh is called with it again on every request until it succeeds.
+func (s *RefreshTokenSource) Token(ctx context.Context) (*Token, error) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	if s.token.Valid() {
+		s.save()
+		return s.token, nil
+	}
+	return s.refresh(ctx)
+}
+
+// Invalidate marks the user access token as expired so that it is refreshed on the next request.
+func (s *RefreshTokenSource) Invalidate(token *Token) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	if s.token != token || s.token == nil {
+		return
+	}
+	expired := *s.token
+	expired.Expiry = time.Now()
+	s.token = &expired
+}
+
+func (s *RefreshTokenSource) refresh(ctx context.Context) (*Token, error) {
+	if s.token == nil || s.token.RefreshToken == "" {
+		return nil, ErrNoRefreshToken
+	}
+	token, err := s.client.Auth.RefreshToken(s.token.RefreshToken).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.token = token
+	s.unsaved = true
+	s.save()
+	return token, nil
+}
+
+// save calls onRefresh with the current token if it has not been saved yet.
+func (s *RefreshTokenSource) save() {
+	if !s.unsaved {
+		return
+	}
+	if s.onRefresh == nil || s.onRefresh(s.token) == nil {
+		s.unsaved = false
+	}
+}
+
+func (c *Client) tokenSourceOf(req *http.Request) TokenSource {
+	if source, ok := req.Context().Value(tokenSourceKey{}).(TokenSource); ok {
+		return source
+	}
+	return c.tokenSource
+}
+
+func (c *Client) doAuthRequest(ctx context.Context, call, method, path string, form url.Values) (*http.Response, error) {
+	url := fmt.Sprintf("%s/%s", c.authURL, strings.TrimPrefix(path, "/"))
+	req, err := http.NewRequestWithContext(ctx, method, url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return c.roundTrip(req, RequestInfo{Resource: "oauth2", Call: call, Method: method, Endpoint: path}, c.httpClient.Do)
+}
+
+func decodeAuthResponse(res *http.Response, v interface{}) error {
+	if res.StatusCode >= http.StatusBadRequest {
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			return err
+		}
+		var apiErr APIError
+		if err := json.Unmarshal(body, &apiErr); err != nil {
+			return newAPIError(res, APIError{}, body)
+		}
+		apiErr.Status = res.StatusCode
+		return newAPIError(res, apiErr, nil)
+	}
+	if v == nil {
+		return nil
+	}
+	return json.NewDecoder(res.Body).Decode(v)
+}
@@ -0,0 +1,160 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ErrDeviceCodeExpired returned when the user did not authorize a device code before it expired.
+var ErrDeviceCodeExpired = errors.New("twitchapi: device code has expired")
+
+// deviceSlowDownDelta is how much longer to wait between polls after being asked to slow down.
+const deviceSlowDownDelta = time.Second * 5
+
+// minDeviceCodeInterval is the shortest time to wait between polls for a device token. Twitch asks for 5 seconds,
+// so shorter intervals sent by Twitch, including a missing interval, are raised to it.
+const minDeviceCodeInterval = time.Second * 5
+
+// AuthorizeURL returns the URL to send a user to so that they can authorize the client with the provided scopes.
+//
+// After the user authorizes the client, they are redirected to the redirect URI with a code that can be exchanged
+// for a token with AuthorizationCode. The state is returned unchanged and should be checked to prevent CSRF attacks.
+//
+//	url := client.Auth.AuthorizeURL("http://localhost:3000", state, "user:manage:whispers")
+func (r *AuthResource) AuthorizeURL(redirectURI, state string, scopes ...string) string {
+	query := url.Values{}
+	query.Set("client_id", r.client.clientID)
+	query.Set("redirect_uri", redirectURI)
+	query.Set("response_type", "code")
+	query.Set("scope", strings.Join(scopes, " "))
+	if state != "" {
+		query.Set("state", state)
+	}
+	return fmt.Sprintf("%s/authorize?%s", r.client.authURL, query.Encode())
+}
+
+// AuthorizationCode creates a request for a user access token using the code from the authorize redirect.
+//
+// The redirect URI must be the same one used to build the authorize URL.
+// The client secret must be set with WithClientSecret.
+func (r *AuthResource) AuthorizationCode(code, redirectURI string) *AuthTokenCall {
+	form := url.Values{}
+	form.Set("client_id", r.client.clientID)
+	form.Set("client_secret", r.client.clientSecret)
+	form.Set("code", code)
+	form.Set("grant_type", "authorization_code")
+	form.Set("redirect_uri", redirectURI)
+	return &AuthTokenCall{resource: r, form: form}
+}
+
+// DeviceCode is a code that a user enters on another device to authorize the client.
+type DeviceCode struct {
+	DeviceCode      string    `json:"device_code"`
+	UserCode        string    `json:"user_code"`
+	VerificationURI string    `json:"verification_uri"`
+	ExpiresIn       int       `json:"expires_in"`
+	Interval        int       `json:"interval"`
+	Expiry          time.Time `json:"-"`
+	Scopes          []string  `json:"-"`
+
+	// MinInterval is the shortest time WaitForDeviceToken waits between polls. Default: 5 seconds
+	MinInterval time.Duration `json:"-"`
+}
+
+// AuthDeviceCodeCall is the API call for starting the device code grant flow.
+type AuthDeviceCodeCall struct {
+	resource *AuthResource
+	scopes   []string
+}
+
+// DeviceCode creates a request for a device code that a user can authorize with the provided scopes.
+//
+// The user should be shown the verification URI and user code while the client waits with WaitForDeviceToken.
+//
+//	code, err := client.Auth.DeviceCode("user:manage:whispers").Do(ctx)
+//	fmt.Printf("Go to %s and enter %s\n", code.VerificationURI, code.UserCode)
+//	token, err := client.Auth.WaitForDeviceToken(ctx, code)
+func (r *AuthResource) DeviceCode(scopes ...string) *AuthDeviceCodeCall {
+	return &AuthDeviceCodeCall{resource: r, scopes: scopes}
+}
+
+// Do executes the request.
+func (c *AuthDeviceCodeCall) Do(ctx context.Context) (*DeviceCode, error) {
+	form := url.Values{}
+	form.Set("client_id", c.resource.client.clientID)
+	form.Set("scopes", strings.Join(c.scopes, " "))
+	res, err := c.resource.client.doAuthRequest(ctx, "AuthDeviceCodeCall", http.MethodPost, "/device", form)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	var code DeviceCode
+	if err := decodeAuthResponse(res, &code); err != nil {
+		return nil, err
+	}
+	code.Expiry = time.Now().Add(time.Duration(code.ExpiresIn) * time.Second)
+	code.Scopes = c.scopes
+	return &code, nil
+}
+
+// DeviceToken creates a request for a user access token using a device code.
+//
+// The request fails with the message authorization_pending until the user has authorized the device code.
+// Use WaitForDeviceToken to poll until the user has done so.
+func (r *AuthResource) DeviceToken(code *DeviceCode) *AuthTokenCall {
+	form := url.Values{}
+	form.Set("client_id", r.client.clientID)
+	form.Set("device_code", code.DeviceCode)
+	form.Set("grant_type", "urn:ietf:params:oauth:grant-type:device_code")
+	form.Set("scopes", strings.Join(code.Scopes, " "))
+	return &AuthTokenCall{resource: r, form: form}
+}
+
+// WaitForDeviceToken polls for a user access token until the user has authorized the device code.
+//
+// The interval requested by Twitch is respected, with a minimum of MinInterval, and increased when asked to slow down.
+// ErrDeviceCodeExpired is returned if the device code expires before it is authorized.
+func (r *AuthResource) WaitForDeviceToken(ctx context.Context, code *DeviceCode) (*Token, error) {
+	minInterval := code.MinInterval
+	if minInterval <= 0 {
+		minInterval = minDeviceCodeInterval
+	}
+	interval := time.Duration(code.Interval) * time.Second
+	if interval < minInterval {
+		interval = minInterval
+	}
+	for {
+		if !code.Expiry.IsZero() && time.Now().After(code.Expiry) {
+			return nil, ErrDeviceCodeExpired
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+
+		token, err := r.DeviceToken(code).Do(ctx)
+		if err == nil {
+			return token, nil
+		}
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			return nil, err
+		}
+		switch strings.ToLower(apiErr.Message) {
+		case "authorization_pending":
+		case "slow_down":
+			interval += deviceSlowDownDelta
+		case "expired_token", "invalid device code":
+			return nil, ErrDeviceCodeExpired
+		default:
+			return nil, err
+		}
+	}
+}
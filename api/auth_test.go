@@ -0,0 +1,402 @@
+package api_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/adeithe/go-twitch/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPI_AppTokenSource(t *testing.T) {
+	tests := []struct {
+		ExpiresIn int
+		Expected  int32
+	}{
+		{5011271, 1},
+		{60, 2}, // Tokens about to expire should be requested again.
+	}
+
+	for _, tt := range tests {
+		var requested int32
+		client := api.New("client-id", api.WithClientSecret("client-secret"), api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+			if req.URL.Host == "id.twitch.tv" {
+				assert.Equal(t, "/oauth2/token", req.URL.Path)
+				if err := req.ParseForm(); err != nil {
+					t.Fatal(err)
+				}
+				assert.Equal(t, "client-id", req.PostForm.Get("client_id"))
+				assert.Equal(t, "client-secret", req.PostForm.Get("client_secret"))
+				assert.Equal(t, "client_credentials", req.PostForm.Get("grant_type"))
+				n := atomic.AddInt32(&requested, 1)
+				return newMockResponse(http.StatusOK, fmt.Sprintf(`{"access_token":"token-%d","expires_in":%d,"token_type":"bearer"}`, n, tt.ExpiresIn)), nil
+			}
+			assert.Equal(t, fmt.Sprintf("Bearer token-%d", atomic.LoadInt32(&requested)), req.Header.Get("Authorization"))
+			return newMockResponse(http.StatusOK, `{"data":[]}`), nil
+		})))
+
+		for i := 0; i < 2; i++ {
+			if _, err := client.Users.List().Do(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+		}
+		assert.Equal(t, tt.Expected, atomic.LoadInt32(&requested))
+	}
+}
+
+func TestAPI_AppTokenSourcePrecedence(t *testing.T) {
+	client := api.New("client-id", api.WithClientSecret("client-secret"), api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Host == "id.twitch.tv" {
+			t.Error("app access token should not be requested when a token is provided")
+		}
+		assert.Equal(t, "Bearer user-token", req.Header.Get("Authorization"))
+		return newMockResponse(http.StatusOK, `{"data":[]}`), nil
+	})))
+
+	_, err := client.Users.List().Do(context.Background(), api.WithBearerToken("user-token"))
+	assert.NoError(t, err)
+}
+
+func TestAPI_AppTokenSourceError(t *testing.T) {
+	client := api.New("client-id", api.WithClientSecret("wrong-secret"), api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Host != "id.twitch.tv" {
+			t.Error("request should not be sent without a token")
+		}
+		return newMockResponse(http.StatusForbidden, `{"status":403,"message":"invalid client secret"}`), nil
+	})))
+
+	_, err := client.Users.List().Do(context.Background())
+	assert.Equal(t, http.StatusForbidden, api.CodeOf(err))
+	assert.EqualError(t, err, "twitchapi: POST /oauth2/token: 403 Forbidden - invalid client secret")
+	assert.ErrorIs(t, err, api.ErrForbidden)
+}
+
+func TestAPI_RefreshTokenSource(t *testing.T) {
+	var refreshed int32
+	client := api.New("client-id", api.WithClientSecret("client-secret"), api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Host == "id.twitch.tv" {
+			if err := req.ParseForm(); err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, "refresh_token", req.PostForm.Get("grant_type"))
+			assert.Equal(t, "refresh-1", req.PostForm.Get("refresh_token"))
+			assert.Equal(t, "client-secret", req.PostForm.Get("client_secret"))
+			atomic.AddInt32(&refreshed, 1)
+			return newMockResponse(http.StatusOK, `{"access_token":"access-2","refresh_token":"refresh-2","expires_in":14124,"scope":["channel:read:subscriptions"],"token_type":"bearer"}`), nil
+		}
+		assert.Equal(t, "Bearer access-2", req.Header.Get("Authorization"))
+		return newMockResponse(http.StatusOK, `{"data":[]}`), nil
+	})))
+
+	var persisted *api.Token
+	source := api.NewRefreshTokenSource(client, &api.Token{
+		AccessToken:  "access-1",
+		RefreshToken: "refresh-1",
+		Expiry:       time.Now().Add(-time.Minute),
+	}, func(token *api.Token) error {
+		persisted = token
+		return nil
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.Users.List().Do(context.Background(), api.WithTokenSource(source)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&refreshed))
+	if assert.NotNil(t, persisted) {
+		assert.Equal(t, "access-2", persisted.AccessToken)
+		assert.Equal(t, "refresh-2", persisted.RefreshToken)
+		assert.Equal(t, []string{"channel:read:subscriptions"}, persisted.Scopes)
+		assert.True(t, persisted.Valid())
+	}
+
+	expired := api.NewRefreshTokenSource(client, &api.Token{AccessToken: "access-1", Expiry: time.Now()}, nil)
+	_, err := expired.Token(context.Background())
+	assert.ErrorIs(t, err, api.ErrNoRefreshToken)
+}
+
+func TestAPI_RefreshTokenSourcePersistError(t *testing.T) {
+	var refreshed int32
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Host == "id.twitch.tv" {
+			atomic.AddInt32(&refreshed, 1)
+			return newMockResponse(http.StatusOK, `{"access_token":"access-2","refresh_token":"refresh-2","expires_in":14124,"token_type":"bearer"}`), nil
+		}
+		assert.Equal(t, "Bearer access-2", req.Header.Get("Authorization"))
+		return newMockResponse(http.StatusOK, `{"data":[]}`), nil
+	})))
+
+	var attempts int
+	var persisted *api.Token
+	source := api.NewRefreshTokenSource(client, &api.Token{
+		AccessToken:  "access-1",
+		RefreshToken: "refresh-1",
+		Expiry:       time.Now().Add(-time.Minute),
+	}, func(token *api.Token) error {
+		if attempts++; attempts == 1 {
+			return fmt.Errorf("database is unavailable")
+		}
+		persisted = token
+		return nil
+	})
+
+	// The request that refreshed the token must not fail because the new token could not be persisted.
+	for i := 0; i < 3; i++ {
+		_, err := client.Users.List().Do(context.Background(), api.WithTokenSource(source))
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&refreshed))
+	assert.Equal(t, 2, attempts)
+	if assert.NotNil(t, persisted) {
+		assert.Equal(t, "refresh-2", persisted.RefreshToken)
+	}
+}
+
+func TestAPI_DefaultTokenSource(t *testing.T) {
+	client := api.New("client-id", api.WithDefaultTokenSource(api.StaticTokenSource(&api.Token{AccessToken: "default"})), api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "Bearer default", req.Header.Get("Authorization"))
+		return newMockResponse(http.StatusOK, `{"data":[]}`), nil
+	})))
+
+	_, err := client.Users.List().Do(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestAPI_InvalidTokenRetry(t *testing.T) {
+	var issued, sent int32
+	client := api.New("client-id", api.WithClientSecret("client-secret"), api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Host == "id.twitch.tv" {
+			n := atomic.AddInt32(&issued, 1)
+			return newMockResponse(http.StatusOK, fmt.Sprintf(`{"access_token":"token-%d","expires_in":5011271,"token_type":"bearer"}`, n)), nil
+		}
+		atomic.AddInt32(&sent, 1)
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"message":"Hello"}`, string(body))
+		if req.Header.Get("Authorization") == "Bearer token-1" {
+			return newMockResponse(http.StatusUnauthorized, `{"error":"Unauthorized","status":401,"message":"Invalid OAuth token"}`), nil
+		}
+		assert.Equal(t, "Bearer token-2", req.Header.Get("Authorization"))
+		return newMockResponse(http.StatusNoContent, ""), nil
+	})))
+
+	err := client.Whispers.Insert("123", "456").Message("Hello").Do(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&issued))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&sent))
+}
+
+func TestAPI_InvalidTokenNoRetry(t *testing.T) {
+	tests := []struct {
+		Name    string
+		Options []api.ClientOption
+		Message string
+	}{
+		{"MissingScope", []api.ClientOption{api.WithClientSecret("client-secret")}, "Missing scope: user:manage:whispers"},
+		{"StaticToken", []api.ClientOption{api.WithDefaultBearerToken("token-1")}, "Invalid OAuth token"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var sent int32
+			opts := append(test.Options, api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+				if req.URL.Host == "id.twitch.tv" {
+					return newMockResponse(http.StatusOK, `{"access_token":"token-1","expires_in":5011271,"token_type":"bearer"}`), nil
+				}
+				atomic.AddInt32(&sent, 1)
+				return newMockResponse(http.StatusUnauthorized, fmt.Sprintf(`{"error":"Unauthorized","status":401,"message":%q}`, test.Message)), nil
+			})))
+			client := api.New("client-id", opts...)
+
+			err := client.Whispers.Insert("123", "456").Message("Hello").Do(context.Background())
+			assert.Equal(t, http.StatusUnauthorized, api.CodeOf(err))
+			assert.Equal(t, int32(1), atomic.LoadInt32(&sent))
+		})
+	}
+}
+
+func TestAPI_AuthValidate(t *testing.T) {
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/oauth2/validate", req.URL.Path)
+		if req.Header.Get("Authorization") != "OAuth user-token" {
+			return newMockResponse(http.StatusUnauthorized, `{"status":401,"message":"invalid access token"}`), nil
+		}
+		return newMockResponse(http.StatusOK, `{"client_id":"client-id","login":"twitchdev","scopes":["moderator:manage:banned_users","user:manage:whispers"],"user_id":"141981764","expires_in":5520838}`), nil
+	})))
+
+	info, err := client.Auth.Validate("user-token").Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "client-id", info.ClientID)
+	assert.Equal(t, "twitchdev", info.Login)
+	assert.Equal(t, "141981764", info.UserID)
+	assert.True(t, info.HasScope("moderator:manage:banned_users"))
+	assert.False(t, info.HasScope("moderator:manage:banned_users", "channel:manage:polls"))
+	assert.NoError(t, info.RequireScope("user:manage:whispers"))
+	assert.ErrorIs(t, info.RequireScope("channel:manage:polls"), api.ErrMissingScope)
+	assert.WithinDuration(t, time.Now().Add(5520838*time.Second), info.Expiry, time.Minute)
+
+	_, err = client.Auth.Validate("revoked-token").Do(context.Background())
+	assert.Equal(t, http.StatusUnauthorized, api.CodeOf(err))
+}
+
+func TestAPI_AuthRevoke(t *testing.T) {
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, "/oauth2/revoke", req.URL.Path)
+		if err := req.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "client-id", req.PostForm.Get("client_id"))
+		if req.PostForm.Get("token") != "user-token" {
+			return newMockResponse(http.StatusBadRequest, `{"status":400,"message":"Invalid token"}`), nil
+		}
+		return newMockResponse(http.StatusOK, ""), nil
+	})))
+
+	assert.NoError(t, client.Auth.Revoke("user-token").Do(context.Background()))
+	assert.Equal(t, http.StatusBadRequest, api.CodeOf(client.Auth.Revoke("other-token").Do(context.Background())))
+}
+
+func TestAPI_TokenValidation(t *testing.T) {
+	var issued, validated int32
+	client := api.New("client-id", api.WithClientSecret("client-secret"), api.WithTokenValidation(time.Hour), api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/oauth2/token":
+			n := atomic.AddInt32(&issued, 1)
+			return newMockResponse(http.StatusOK, fmt.Sprintf(`{"access_token":"token-%d","expires_in":5011271,"token_type":"bearer"}`, n)), nil
+		case "/oauth2/validate":
+			atomic.AddInt32(&validated, 1)
+			if req.Header.Get("Authorization") == "OAuth token-1" {
+				return newMockResponse(http.StatusUnauthorized, `{"status":401,"message":"invalid access token"}`), nil
+			}
+			return newMockResponse(http.StatusOK, `{"client_id":"client-id","scopes":[],"expires_in":5011271}`), nil
+		}
+		assert.Equal(t, "Bearer token-2", req.Header.Get("Authorization"))
+		return newMockResponse(http.StatusOK, `{"data":[]}`), nil
+	})))
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.Users.List().Do(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&issued))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&validated))
+}
+
+func TestAPI_AuthorizeURL(t *testing.T) {
+	client := api.New("client-id")
+	u, err := url.Parse(client.Auth.AuthorizeURL("http://localhost:3000", "c3ab8aa609ea11e793ae92361f002671", "user:manage:whispers", "channel:manage:redemptions"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "id.twitch.tv", u.Host)
+	assert.Equal(t, "/oauth2/authorize", u.Path)
+	assert.Equal(t, "client-id", u.Query().Get("client_id"))
+	assert.Equal(t, "http://localhost:3000", u.Query().Get("redirect_uri"))
+	assert.Equal(t, "code", u.Query().Get("response_type"))
+	assert.Equal(t, "user:manage:whispers channel:manage:redemptions", u.Query().Get("scope"))
+	assert.Equal(t, "c3ab8aa609ea11e793ae92361f002671", u.Query().Get("state"))
+}
+
+func TestAPI_AuthorizationCode(t *testing.T) {
+	client := api.New("client-id", api.WithClientSecret("client-secret"), api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		if err := req.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "authorization_code", req.PostForm.Get("grant_type"))
+		assert.Equal(t, "code-1", req.PostForm.Get("code"))
+		assert.Equal(t, "client-secret", req.PostForm.Get("client_secret"))
+		assert.Equal(t, "http://localhost:3000", req.PostForm.Get("redirect_uri"))
+		return newMockResponse(http.StatusOK, `{"access_token":"access-1","refresh_token":"refresh-1","expires_in":14124,"scope":["user:manage:whispers"],"token_type":"bearer"}`), nil
+	})))
+
+	token, err := client.Auth.AuthorizationCode("code-1", "http://localhost:3000").Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "access-1", token.AccessToken)
+	assert.Equal(t, "refresh-1", token.RefreshToken)
+}
+
+func TestAPI_DeviceCodeFlow(t *testing.T) {
+	var polls int32
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		if err := req.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "user:manage:whispers", req.PostForm.Get("scopes"))
+		switch req.URL.Path {
+		case "/oauth2/device":
+			return newMockResponse(http.StatusOK, `{"device_code":"device-1","expires_in":1800,"interval":0,"user_code":"ABCDEFGH","verification_uri":"https://www.twitch.tv/activate?public=true&device-code=ABCDEFGH"}`), nil
+		case "/oauth2/token":
+			assert.Equal(t, "urn:ietf:params:oauth:grant-type:device_code", req.PostForm.Get("grant_type"))
+			assert.Equal(t, "device-1", req.PostForm.Get("device_code"))
+			if atomic.AddInt32(&polls, 1) < 2 {
+				return newMockResponse(http.StatusBadRequest, `{"status":400,"message":"authorization_pending"}`), nil
+			}
+			return newMockResponse(http.StatusOK, `{"access_token":"access-1","refresh_token":"refresh-1","expires_in":14124,"scope":["user:manage:whispers"],"token_type":"bearer"}`), nil
+		}
+		t.Fatalf("unexpected request to %s", req.URL)
+		return nil, nil
+	})))
+
+	code, err := client.Auth.DeviceCode("user:manage:whispers").Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "ABCDEFGH", code.UserCode)
+	code.MinInterval = time.Millisecond * 10
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+	token, err := client.Auth.WaitForDeviceToken(ctx, code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "access-1", token.AccessToken)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&polls))
+
+	code.Expiry = time.Now().Add(-time.Second)
+	_, err = client.Auth.WaitForDeviceToken(ctx, code)
+	assert.ErrorIs(t, err, api.ErrDeviceCodeExpired)
+}
+
+func TestAPI_DeviceCodePollInterval(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Message     string
+		MinInterval time.Duration
+		Expected    int32
+	}{
+		{"MissingInterval", "authorization_pending", 0, 0},  // Polling without an interval waits for the minimum.
+		{"SlowDown", "slow_down", time.Millisecond * 10, 1}, // Slowing down waits longer than the test allows.
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var polls int32
+			client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+				atomic.AddInt32(&polls, 1)
+				return newMockResponse(http.StatusBadRequest, fmt.Sprintf(`{"status":400,"message":%q}`, test.Message)), nil
+			})))
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*500)
+			defer cancel()
+			_, err := client.Auth.WaitForDeviceToken(ctx, &api.DeviceCode{DeviceCode: "device-1", MinInterval: test.MinInterval})
+			assert.ErrorIs(t, err, context.DeadlineExceeded)
+			assert.Equal(t, test.Expected, atomic.LoadInt32(&polls))
+		})
+	}
+}
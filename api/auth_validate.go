@@ -0,0 +1,209 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// ErrMissingScope returned when a token has not been granted a scope that is required for a request.
+var ErrMissingScope = errors.New("twitchapi: token is missing a required scope")
+
+// defaultValidateInterval is how often tokens are validated by default. Twitch requires at least once an hour.
+const defaultValidateInterval = time.Hour
+
+// TokenInfo is the information about an access token returned when it is validated.
+type TokenInfo struct {
+	ClientID  string    `json:"client_id"`
+	Login     string    `json:"login,omitempty"`   // Only present for user access tokens.
+	UserID    string    `json:"user_id,omitempty"` // Only present for user access tokens.
+	Scopes    []string  `json:"scopes"`
+	ExpiresIn int       `json:"expires_in"`
+	Expiry    time.Time `json:"-"` // Zero if the token does not expire.
+}
+
+// HasScope returns true if the token has been granted all of the provided scopes.
+func (info *TokenInfo) HasScope(scopes ...string) bool {
+	if info == nil {
+		return false
+	}
+	for _, scope := range scopes {
+		var found bool
+		for _, s := range info.Scopes {
+			if s == scope {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// RequireScope returns ErrMissingScope if the token has not been granted all of the provided scopes.
+//
+//	info, err := client.Auth.Validate(token).Do(ctx)
+//	if err := info.RequireScope("moderator:manage:banned_users"); err != nil {
+//		return err
+//	}
+func (info *TokenInfo) RequireScope(scopes ...string) error {
+	for _, scope := range scopes {
+		if !info.HasScope(scope) {
+			return fmt.Errorf("%w: %s", ErrMissingScope, scope)
+		}
+	}
+	return nil
+}
+
+// AuthValidateCall is the API call for validating an access token.
+type AuthValidateCall struct {
+	resource *AuthResource
+	token    string
+}
+
+// Validate creates a request to validate an access token and get the information about it.
+//
+// Twitch requires apps that use tokens for a long time to validate them every hour.
+// See NewValidatingTokenSource to do this automatically.
+func (r *AuthResource) Validate(accessToken string) *AuthValidateCall {
+	return &AuthValidateCall{resource: r, token: accessToken}
+}
+
+// Do executes the request.
+//
+// If the token is not valid, an APIError with the status 401 is returned.
+func (c *AuthValidateCall) Do(ctx context.Context) (*TokenInfo, error) {
+	url := fmt.Sprintf("%s/validate", c.resource.client.authURL)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("OAuth %s", c.token))
+
+	res, err := c.resource.client.roundTrip(req, RequestInfo{Resource: "oauth2", Call: "AuthValidateCall", Method: http.MethodGet, Endpoint: "/validate"}, c.resource.client.httpClient.Do)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	var info TokenInfo
+	if err := decodeAuthResponse(res, &info); err != nil {
+		return nil, err
+	}
+	if info.ExpiresIn > 0 {
+		info.Expiry = time.Now().Add(time.Duration(info.ExpiresIn) * time.Second)
+	}
+	return &info, nil
+}
+
+// AuthRevokeCall is the API call for revoking an access token.
+type AuthRevokeCall struct {
+	resource *AuthResource
+	form     url.Values
+}
+
+// Revoke creates a request to revoke an access token that was issued to the client.
+func (r *AuthResource) Revoke(accessToken string) *AuthRevokeCall {
+	form := url.Values{}
+	form.Set("client_id", r.client.clientID)
+	form.Set("token", accessToken)
+	return &AuthRevokeCall{resource: r, form: form}
+}
+
+// Do executes the request.
+func (c *AuthRevokeCall) Do(ctx context.Context) error {
+	res, err := c.resource.client.doAuthRequest(ctx, "AuthRevokeCall", http.MethodPost, "/revoke", c.form)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	return decodeAuthResponse(res, nil)
+}
+
+// ValidatingTokenSource validates the tokens of another TokenSource before they are used.
+//
+// A token is validated when it is first used and again once the interval has passed. If the API reports that the
+// token is no longer valid, it is invalidated and a new token is requested from the underlying source.
+type ValidatingTokenSource struct {
+	client   *Client
+	source   TokenSource
+	interval time.Duration
+
+	token     *Token
+	info      *TokenInfo
+	validated time.Time
+	mx        sync.Mutex
+}
+
+var _ TokenSource = &ValidatingTokenSource{}
+var _ TokenInvalidator = &ValidatingTokenSource{}
+
+// NewValidatingTokenSource creates a TokenSource that validates the tokens of the provided source.
+//
+// The interval defaults to 1 hour if it is not positive.
+func NewValidatingTokenSource(client *Client, source TokenSource, interval time.Duration) *ValidatingTokenSource {
+	if interval <= 0 {
+		interval = defaultValidateInterval
+	}
+	return &ValidatingTokenSource{client: client, source: source, interval: interval}
+}
+
+// Token returns a token from the underlying source, validating it if needed.
+func (s *ValidatingTokenSource) Token(ctx context.Context) (*Token, error) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	token, err := s.source.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if token == s.token && time.Since(s.validated) < s.interval {
+		return token, nil
+	}
+
+	info, err := s.client.Auth.Validate(token.AccessToken).Do(ctx)
+	if errors.Is(err, ErrUnauthorized) {
+		invalidator, ok := s.source.(TokenInvalidator)
+		if !ok {
+			return nil, err
+		}
+		invalidator.Invalidate(token)
+		if token, err = s.source.Token(ctx); err != nil {
+			return nil, err
+		}
+		info, err = s.client.Auth.Validate(token.AccessToken).Do(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.token, s.info, s.validated = token, info, time.Now()
+	return token, nil
+}
+
+// Info returns the information about the token from when it was last validated.
+//
+// Nil is returned if no token has been validated yet.
+func (s *ValidatingTokenSource) Info() *TokenInfo {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	return s.info
+}
+
+// Invalidate passes the token to the underlying source if it can be invalidated.
+func (s *ValidatingTokenSource) Invalidate(token *Token) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	if s.token == token {
+		s.token, s.info = nil, nil
+	}
+	if invalidator, ok := s.source.(TokenInvalidator); ok {
+		invalidator.Invalidate(token)
+	}
+}
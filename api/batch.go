@@ -0,0 +1,148 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+// maxLookupValues is the most IDs or logins Twitch accepts in a single request.
+const maxLookupValues = 100
+
+// defaultBatchConcurrency is how many batched requests are sent at the same time by default.
+const defaultBatchConcurrency = 4
+
+// lookup holds the query values of a call that may need to be split across several requests.
+type lookup struct {
+	keys   []string
+	values []string
+}
+
+// add adds values for the query parameter with the provided key.
+func (l *lookup) add(key string, values ...string) {
+	for _, value := range values {
+		l.keys = append(l.keys, key)
+		l.values = append(l.values, value)
+	}
+}
+
+// chunks splits the values into sets of request options with no more than n values each.
+//
+// A single empty set is returned if there are no values.
+func (l lookup) chunks(n int) [][]RequestOption {
+	if len(l.values) < 1 {
+		return [][]RequestOption{nil}
+	}
+	var chunks [][]RequestOption
+	for i := 0; i < len(l.values); i += n {
+		var chunk []RequestOption
+		for j := i; j < i+n && j < len(l.values); j++ {
+			chunk = append(chunk, AddQueryParameter(l.keys[j], l.values[j]))
+		}
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
+
+// batchPage is the result of a single request made for a batched call.
+type batchPage[T any] struct {
+	Header http.Header
+	Data   []T
+	Cursor string
+}
+
+// doBatched calls fetch for every chunk of lookup values and merges the results in order.
+//
+// Requests are sent concurrently up to the batch concurrency of the client. When the values are split into more
+// than one chunk, the cursor of every chunk is followed until all of its pages are fetched, as the cursors of
+// separate requests can not be combined into a single cursor for the caller.
+func doBatched[T any](ctx context.Context, client *Client, l lookup, fetch func(context.Context, []RequestOption) (*batchPage[T], error)) (*batchPage[T], error) {
+	chunks := l.chunks(maxLookupValues)
+	if len(chunks) == 1 {
+		return fetch(ctx, chunks[0])
+	}
+
+	concurrency := client.batchConcurrency
+	if concurrency < 1 {
+		concurrency = defaultBatchConcurrency
+	}
+	batchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pages := make([]*batchPage[T], len(chunks))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	var once sync.Once
+	var batchErr error
+	for i, chunk := range chunks {
+		wg.Add(1)
+		go func(i int, chunk []RequestOption) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-batchCtx.Done():
+				return
+			}
+			defer func() { <-sem }()
+
+			page, err := fetchAll(batchCtx, chunk, fetch)
+			if err != nil {
+				once.Do(func() {
+					batchErr = err
+					cancel()
+				})
+				return
+			}
+			pages[i] = page
+		}(i, chunk)
+	}
+	wg.Wait()
+	if batchErr != nil {
+		return nil, batchErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	merged := &batchPage[T]{Header: pages[0].Header}
+	for _, page := range pages {
+		merged.Data = append(merged.Data, page.Data...)
+	}
+	return merged, nil
+}
+
+// fetchAll calls fetch for a chunk of lookup values and follows the cursor until every page is fetched.
+func fetchAll[T any](ctx context.Context, chunk []RequestOption, fetch func(context.Context, []RequestOption) (*batchPage[T], error)) (*batchPage[T], error) {
+	page, err := fetch(ctx, chunk)
+	if err != nil {
+		return nil, err
+	}
+	for cursor := page.Cursor; len(cursor) > 0; {
+		next, err := fetch(ctx, joinOptions(chunk, []RequestOption{afterCursor(cursor)}))
+		if err != nil {
+			return nil, err
+		}
+		page.Data = append(page.Data, next.Data...)
+		if next.Cursor == cursor {
+			break
+		}
+		cursor = next.Cursor
+	}
+	page.Cursor = ""
+	return page, nil
+}
+
+// joinOptions returns a new slice with the provided sets of request options in order.
+//
+// Unlike append, the slices are never shared so the result is safe to use from concurrent requests.
+func joinOptions(sets ...[]RequestOption) []RequestOption {
+	var n int
+	for _, set := range sets {
+		n += len(set)
+	}
+	opts := make([]RequestOption, 0, n)
+	for _, set := range sets {
+		opts = append(opts, set...)
+	}
+	return opts
+}
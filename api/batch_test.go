@@ -0,0 +1,112 @@
+package api_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/adeithe/go-twitch/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPI_BatchedLookup(t *testing.T) {
+	var sent, active, peak int32
+	client := api.New("client-id", api.WithBatchConcurrency(2), api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&sent, 1)
+		n := atomic.AddInt32(&active, 1)
+		defer atomic.AddInt32(&active, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond * 10)
+
+		ids := req.URL.Query()["id"]
+		logins := req.URL.Query()["login"]
+		assert.LessOrEqual(t, len(ids)+len(logins), 100)
+		var users []string
+		for _, id := range ids {
+			users = append(users, fmt.Sprintf(`{"id":%q}`, id))
+		}
+		for _, login := range logins {
+			users = append(users, fmt.Sprintf(`{"login":%q}`, login))
+		}
+		return newMockResponse(http.StatusOK, fmt.Sprintf(`{"data":[%s]}`, strings.Join(users, ","))), nil
+	})))
+
+	var ids, logins []string
+	for i := 0; i < 250; i++ {
+		ids = append(ids, fmt.Sprint(i))
+	}
+	for i := 0; i < 60; i++ {
+		logins = append(logins, fmt.Sprintf("user%d", i))
+	}
+
+	res, err := client.Users.List().ID(ids).Login(logins).Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int32(4), atomic.LoadInt32(&sent))
+	assert.LessOrEqual(t, atomic.LoadInt32(&peak), int32(2))
+	if assert.Len(t, res.Data, 310) {
+		assert.Equal(t, "0", res.Data[0].ID)
+		assert.Equal(t, "249", res.Data[249].ID)
+		assert.Equal(t, "user59", res.Data[309].Login)
+	}
+}
+
+func TestAPI_BatchedLookupError(t *testing.T) {
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Query().Get("broadcaster_id") == "100" {
+			return newMockResponse(http.StatusNotFound, `{"error":"Not Found","status":404,"message":""}`), nil
+		}
+		return newMockResponse(http.StatusOK, `{"data":[]}`), nil
+	})))
+
+	var ids []string
+	for i := 0; i < 150; i++ {
+		ids = append(ids, fmt.Sprint(i))
+	}
+	_, err := client.Channels.List().BroadcasterID(ids).Do(context.Background())
+	assert.Error(t, err)
+}
+
+func TestAPI_BatchedLookupPages(t *testing.T) {
+	var sent int32
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&sent, 1)
+		query := req.URL.Query()
+		ids := query["user_id"]
+		// The first chunk has a second page of streams, as if only some of the streams fit in the first.
+		if ids[0] == "0" && query.Get("after") == "" {
+			return newMockResponse(http.StatusOK, `{"data":[{"user_id":"0"}],"pagination":{"cursor":"page-2"}}`), nil
+		}
+		if ids[0] == "0" {
+			assert.Equal(t, "page-2", query.Get("after"))
+			return newMockResponse(http.StatusOK, `{"data":[{"user_id":"1"}],"pagination":{}}`), nil
+		}
+		return newMockResponse(http.StatusOK, fmt.Sprintf(`{"data":[{"user_id":%q}],"pagination":{}}`, ids[0])), nil
+	})))
+
+	var ids []string
+	for i := 0; i < 150; i++ {
+		ids = append(ids, fmt.Sprint(i))
+	}
+	res, err := client.Streams.List().UserID(ids).Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int32(3), atomic.LoadInt32(&sent))
+	assert.Empty(t, res.Cursor)
+	if assert.Len(t, res.Data, 3) {
+		assert.Equal(t, "0", res.Data[0].UserID)
+		assert.Equal(t, "1", res.Data[1].UserID)
+		assert.Equal(t, "100", res.Data[2].UserID)
+	}
+}
@@ -0,0 +1,199 @@
+package api
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultCacheSize is how many responses the default cache holds.
+const defaultCacheSize = 1000
+
+// DefaultCacheTTLs are how long responses are cached for each path when a cache is set with WithCache.
+//
+// Use WithCacheTTL to change them or cache other paths.
+var DefaultCacheTTLs = map[string]time.Duration{
+	"/users":              time.Minute * 5,
+	"/games":              time.Hour,
+	"/bits/cheermotes":    time.Hour,
+	"/chat/emotes/global": time.Hour,
+	"/chat/badges/global": time.Hour,
+}
+
+// Cache stores API responses so that repeated requests can be served without contacting Twitch.
+//
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the response stored with the key, including responses that have expired.
+	Get(key string) (*CachedResponse, bool)
+	// Set stores the response with the key.
+	Set(key string, res *CachedResponse)
+}
+
+// CachedResponse is an API response stored in a Cache.
+type CachedResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+	Expiry     time.Time
+}
+
+// Fresh returns true if the response can be used without checking with Twitch.
+func (res *CachedResponse) Fresh() bool {
+	return time.Now().Before(res.Expiry)
+}
+
+// response creates an HTTP response from the cached response.
+func (res *CachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        strconv.Itoa(res.StatusCode) + " " + http.StatusText(res.StatusCode),
+		StatusCode:    res.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        res.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(res.Body)),
+		ContentLength: int64(len(res.Body)),
+		Request:       req,
+	}
+}
+
+// LRUCache is an in-memory Cache that removes the least recently used responses once it is full.
+type LRUCache struct {
+	size    int
+	entries map[string]*list.Element
+	order   *list.List
+	mx      sync.Mutex
+}
+
+type lruEntry struct {
+	key string
+	res *CachedResponse
+}
+
+var _ Cache = &LRUCache{}
+
+// NewLRUCache creates an in-memory cache that holds up to the provided number of responses.
+//
+// The size defaults to 1000 if it is not positive.
+func NewLRUCache(size int) *LRUCache {
+	if size < 1 {
+		size = defaultCacheSize
+	}
+	return &LRUCache{size: size, entries: make(map[string]*list.Element), order: list.New()}
+}
+
+// Get returns the response stored with the key.
+func (c *LRUCache) Get(key string) (*CachedResponse, bool) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+	return el.Value.(*lruEntry).res, true
+}
+
+// Set stores the response with the key, removing the least recently used response if the cache is full.
+func (c *LRUCache) Set(key string, res *CachedResponse) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	if el, ok := c.entries[key]; ok {
+		el.Value.(*lruEntry).res = res
+		c.order.MoveToFront(el)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&lruEntry{key, res})
+	for c.order.Len() > c.size {
+		el := c.order.Back()
+		c.order.Remove(el)
+		delete(c.entries, el.Value.(*lruEntry).key)
+	}
+}
+
+// Len returns the number of responses in the cache.
+func (c *LRUCache) Len() int {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	return c.order.Len()
+}
+
+// cacheTTL returns how long the response for the path may be cached, or zero if it should not be cached.
+func (c *Client) cacheTTL(method, path string) time.Duration {
+	if c.cache == nil || method != http.MethodGet {
+		return 0
+	}
+	path = "/" + strings.TrimPrefix(strings.SplitN(path, "?", 2)[0], "/")
+	return c.cacheTTLs[path]
+}
+
+// cacheKey returns the key for a request. Responses depend on the token used, so it is included as a hash.
+func cacheKey(req *http.Request) string {
+	sum := sha256.Sum256([]byte(req.Header.Get("Authorization")))
+	return req.Method + " " + req.URL.String() + " " + hex.EncodeToString(sum[:8])
+}
+
+// storeResponse caches a successful response and returns a copy that can still be read by the caller.
+//
+// A 304 Not Modified response refreshes the cached response, which is returned instead.
+func (c *Client) storeResponse(key string, ttl time.Duration, cached *CachedResponse, res *http.Response) (*http.Response, error) {
+	if res.StatusCode == http.StatusNotModified && cached != nil {
+		res.Body.Close()
+		refreshed := *cached
+		if ttl = responseTTL(res.Header, ttl); ttl < 0 {
+			ttl = 0
+		}
+		refreshed.Expiry = time.Now().Add(ttl)
+		c.cache.Set(key, &refreshed)
+		return refreshed.response(res.Request), nil
+	}
+	if res.StatusCode != http.StatusOK {
+		return res, nil
+	}
+	ttl = responseTTL(res.Header, ttl)
+	if ttl < 0 || (ttl == 0 && res.Header.Get("ETag") == "") {
+		return res, nil
+	}
+
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	entry := &CachedResponse{
+		StatusCode: res.StatusCode,
+		Header:     res.Header.Clone(),
+		Body:       body,
+		Expiry:     time.Now().Add(ttl),
+	}
+	c.cache.Set(key, entry)
+	return entry.response(res.Request), nil
+}
+
+// responseTTL applies the Cache-Control header of a response to the TTL of its path.
+//
+// A negative TTL is returned if the response must not be stored at all.
+func responseTTL(header http.Header, ttl time.Duration) time.Duration {
+	for _, directive := range strings.Split(header.Get("Cache-Control"), ",") {
+		directive = strings.ToLower(strings.TrimSpace(directive))
+		switch {
+		case directive == "no-store":
+			return -1
+		case directive == "no-cache":
+			return 0
+		case strings.HasPrefix(directive, "max-age="):
+			if age, err := strconv.Atoi(strings.TrimPrefix(directive, "max-age=")); err == nil {
+				return time.Duration(age) * time.Second
+			}
+		}
+	}
+	return ttl
+}
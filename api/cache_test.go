@@ -0,0 +1,136 @@
+package api_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/adeithe/go-twitch/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPI_Cache(t *testing.T) {
+	var sent int32
+	client := api.New("client-id", api.WithCache(nil), api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		n := atomic.AddInt32(&sent, 1)
+		return newMockResponse(http.StatusOK, fmt.Sprintf(`{"data":[{"id":"%d","login":%q}]}`, n, req.URL.Query().Get("login"))), nil
+	})))
+
+	for i := 0; i < 3; i++ {
+		res, err := client.Users.List().Login([]string{"twitchdev"}).Do(context.Background(), api.WithBearerToken("token-1"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if assert.Len(t, res.Data, 1) {
+			assert.Equal(t, "1", res.Data[0].ID)
+		}
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&sent))
+
+	_, err := client.Users.List().Login([]string{"twitchdev"}).Do(context.Background(), api.WithBearerToken("token-2"))
+	assert.NoError(t, err)
+	_, err = client.Streams.List().Do(context.Background(), api.WithBearerToken("token-1"))
+	assert.NoError(t, err)
+	_, err = client.Streams.List().Do(context.Background(), api.WithBearerToken("token-1"))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), atomic.LoadInt32(&sent))
+}
+
+func TestAPI_CacheRevalidate(t *testing.T) {
+	var sent, notModified int32
+	client := api.New("client-id", api.WithCache(nil), api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&sent, 1)
+		if req.Header.Get("If-None-Match") == `"v1"` {
+			atomic.AddInt32(&notModified, 1)
+			res := newMockResponse(http.StatusNotModified, "")
+			res.Header.Set("Cache-Control", "max-age=60")
+			return res, nil
+		}
+		res := newMockResponse(http.StatusOK, `{"data":[{"prefix":"Cheer"}]}`)
+		res.Header.Set("ETag", `"v1"`)
+		res.Header.Set("Cache-Control", "no-cache")
+		return res, nil
+	})))
+
+	for i := 0; i < 3; i++ {
+		res, err := client.Bits.Cheermotes.List().Do(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Len(t, res.Data, 1)
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&sent))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&notModified))
+}
+
+func TestAPI_CacheNoStore(t *testing.T) {
+	var sent int32
+	client := api.New("client-id", api.WithCache(nil), api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&sent, 1)
+		res := newMockResponse(http.StatusOK, `{"data":[]}`)
+		res.Header.Set("Cache-Control", "no-store")
+		return res, nil
+	})))
+
+	for i := 0; i < 2; i++ {
+		_, err := client.Users.List().Do(context.Background())
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&sent))
+}
+
+func TestAPI_LRUCache(t *testing.T) {
+	cache := api.NewLRUCache(2)
+	expiry := time.Now().Add(time.Minute)
+	cache.Set("a", &api.CachedResponse{Expiry: expiry})
+	cache.Set("b", &api.CachedResponse{Expiry: expiry})
+	_, ok := cache.Get("a")
+	assert.True(t, ok)
+	cache.Set("c", &api.CachedResponse{Expiry: expiry})
+
+	_, ok = cache.Get("b")
+	assert.False(t, ok)
+	_, ok = cache.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 2, cache.Len())
+}
+
+func TestAPI_CacheDefaultTTLs(t *testing.T) {
+	sent := make(map[string]int)
+	client := api.New("client-id", api.WithCache(nil), api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		sent[req.URL.Path]++
+		switch req.URL.Path {
+		case "/helix/chat/emotes/global":
+			return newMockResponse(http.StatusOK, `{"data":[{"id":"196892","name":"TwitchUnity"}],"template":"https://static-cdn.jtvnw.net/emoticons/v2/{{id}}/{{format}}/{{theme_mode}}/{{scale}}"}`), nil
+		case "/helix/chat/badges/global":
+			return newMockResponse(http.StatusOK, `{"data":[{"set_id":"vip","versions":[{"id":"1","title":"VIP"}]}]}`), nil
+		}
+		return newMockResponse(http.StatusOK, `{"data":[{"id":"33214","name":"Fortnite"}]}`), nil
+	})))
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		games, err := client.Games.List().Name([]string{"Fortnite"}).Do(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "33214", games.Data[0].ID)
+
+		emotes, err := client.Chat.Emotes.Global().Do(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "TwitchUnity", emotes.Data[0].Name)
+		assert.Contains(t, emotes.Template, "{{id}}")
+
+		badges, err := client.Chat.Badges.Global().Do(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "VIP", badges.Data[0].Versions[0].Title)
+	}
+	assert.Equal(t, map[string]int{"/helix/games": 1, "/helix/chat/emotes/global": 1, "/helix/chat/badges/global": 1}, sent)
+}
@@ -1,19 +1,33 @@
 package api
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"strings"
+	"time"
 )
 
 type Client struct {
 	clientID     string
 	clientSecret string
-	bearerToken  string
+	baseURL      string
+	authURL      string
+	tokenSource  TokenSource
 	httpClient   HTTPClient
 
+	validateInterval time.Duration
+	rateLimiter      *rateLimiter
+	retryPolicy      *RetryPolicy
+	batchConcurrency int
+	middleware       []Middleware
+	cache            Cache
+	cacheTTLs        map[string]time.Duration
+
+	Auth          *AuthResource
 	Ads           *AdsResource
 	Analytics     *AnalyticsResource
 	Bits          *BitsResource
@@ -54,11 +68,31 @@ func New(clientID string, opts ...ClientOption) *Client {
 		WithHTTPClient(http.DefaultClient),
 	}
 
-	client := &Client{clientID: clientID}
+	client := &Client{
+		clientID:    clientID,
+		baseURL:     BaseURL,
+		authURL:     AuthURL,
+		rateLimiter: newRateLimiter(),
+	}
 	for _, opt := range append(defaultOpts, opts...) {
 		opt(client)
 	}
 
+	if client.tokenSource == nil && client.clientSecret != "" {
+		client.tokenSource = NewAppTokenSource(client)
+	}
+	if client.cache != nil {
+		for path, ttl := range DefaultCacheTTLs {
+			if _, ok := client.cacheTTLs[path]; !ok {
+				client.cacheTTLs[path] = ttl
+			}
+		}
+	}
+	if client.tokenSource != nil && client.validateInterval > 0 {
+		client.tokenSource = NewValidatingTokenSource(client, client.tokenSource, client.validateInterval)
+	}
+
+	client.Auth = NewAuthResource(client)
 	client.Ads = NewAdsResource(client)
 	client.Analytics = NewAnalyticsResource(client)
 	client.Bits = NewBitsResource(client)
@@ -91,11 +125,103 @@ func New(clientID string, opts ...ClientOption) *Client {
 	return client
 }
 
-func (c *Client) doRequest(ctx context.Context, method, path string, body io.Reader, opts ...RequestOption) (*http.Response, error) {
-	url := fmt.Sprintf("%s/%s", BaseURL, strings.TrimPrefix(path, "/"))
+func (c *Client) doRequest(ctx context.Context, call, method, path string, body io.Reader, opts ...RequestOption) (*http.Response, error) {
+	// The body is buffered so that the request can be replayed after being rate limited or refreshing the token.
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = io.ReadAll(body); err != nil {
+			return nil, err
+		}
+	}
+
+	info := newRequestInfo(call, method, path)
+	var invalidated bool
+	// Rate limited requests and failures are retried separately, so that retrying one does not use up the other.
+	var retries, rateLimited int
+	for attempt := 0; ; attempt++ {
+		info.Attempt = attempt
+		req, token, err := c.newRequest(ctx, method, path, payload, opts...)
+		if err != nil {
+			return nil, err
+		}
+		var key string
+		var cached *CachedResponse
+		ttl := c.cacheTTL(method, path)
+		if ttl > 0 {
+			key = cacheKey(req)
+			if entry, ok := c.cache.Get(key); ok {
+				if entry.Fresh() {
+					cachedInfo := info
+					cachedInfo.Cached = true
+					return c.roundTrip(req, cachedInfo, func(req *http.Request) (*http.Response, error) {
+						return entry.response(req), nil
+					})
+				}
+				if etag := entry.Header.Get("ETag"); etag != "" {
+					req.Header.Set("If-None-Match", etag)
+					cached = entry
+				}
+			}
+		}
+
+		bucket := c.rateLimiter.bucket(req.Header.Get("Authorization"))
+		if err := bucket.take(ctx); err != nil {
+			return nil, err
+		}
+		res, err := c.roundTrip(req, info, c.httpClient.Do)
+		if err != nil {
+			if !c.retryPolicy.retryError(ctx, method, err, retries) {
+				return nil, err
+			}
+			if err := c.retryPolicy.wait(ctx, retries); err != nil {
+				return nil, err
+			}
+			retries++
+			continue
+		}
+		bucket.update(res.Header)
+
+		switch {
+		case c.retryPolicy.retryStatus(method, res.StatusCode, retries):
+			res.Body.Close()
+			if err := c.retryPolicy.wait(ctx, retries); err != nil {
+				return nil, err
+			}
+			retries++
+			continue
+		case res.StatusCode == http.StatusTooManyRequests && rateLimited < maxRateLimitRetries && exhausted(res.Header):
+			res.Body.Close()
+			bucket.exhaust(retryAfter(res))
+			rateLimited++
+			continue
+		case token != nil && !invalidated && isInvalidTokenResponse(res):
+			invalidator, ok := c.tokenSourceOf(req).(TokenInvalidator)
+			if !ok {
+				return res, nil
+			}
+			res.Body.Close()
+			invalidator.Invalidate(token)
+			invalidated = true
+			continue
+		}
+		if ttl > 0 {
+			return c.storeResponse(key, ttl, cached, res)
+		}
+		return res, nil
+	}
+}
+
+// newRequest builds a request for the API and returns the token from the token source if one was used.
+func (c *Client) newRequest(ctx context.Context, method, path string, payload []byte, opts ...RequestOption) (*http.Request, *Token, error) {
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+	url := fmt.Sprintf("%s/%s", c.baseURL, strings.TrimPrefix(path, "/"))
 	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -104,11 +230,37 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body io.Rea
 		opt(req)
 	}
 
-	if c.bearerToken != "" {
-		if req.Header.Get("Authorization") == "" {
-			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
-		}
+	if req.Header.Get("Authorization") != "" {
+		return req, nil, nil
+	}
+	source := c.tokenSourceOf(req)
+	if source == nil {
+		return req, nil, nil
 	}
+	token, err := source.Token(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token.AccessToken))
+	return req, token, nil
+}
 
-	return c.httpClient.Do(req)
+// isInvalidTokenResponse returns true if the API rejected the access token used for the request.
+//
+// Other 401 responses, such as a missing scope, are left untouched as a new token would not help.
+func isInvalidTokenResponse(res *http.Response) bool {
+	if res.StatusCode != http.StatusUnauthorized {
+		return false
+	}
+	data, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	res.Body = io.NopCloser(bytes.NewReader(data))
+	if err != nil {
+		return false
+	}
+	var apiErr APIError
+	if err := json.Unmarshal(data, &apiErr); err != nil {
+		return false
+	}
+	return strings.EqualFold(apiErr.Message, "Invalid OAuth token")
 }
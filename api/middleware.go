@@ -0,0 +1,70 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// RoundTripFunc sends a request to Twitch and returns the response.
+type RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps the round trip of every request sent by the client.
+//
+// Middleware can inspect or change the request before calling next, and inspect or replace the response after.
+// Requests answered from the response cache also pass through the middleware, with RequestInfo.Cached set.
+// Use RequestInfoFrom with the context of the request to get the API call it was made for.
+//
+//	logger := func(next api.RoundTripFunc) api.RoundTripFunc {
+//		return func(req *http.Request) (*http.Response, error) {
+//			info, _ := api.RequestInfoFrom(req.Context())
+//			res, err := next(req)
+//			log.Printf("%s %s (attempt %d): %v", info.Call, info.Endpoint, info.Attempt, err)
+//			return res, err
+//		}
+//	}
+//	client := api.New(clientID, api.WithMiddleware(logger))
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// RequestInfo describes the API call a request was sent for.
+type RequestInfo struct {
+	Resource string // The first segment of the endpoint, such as "users" or "channel_points".
+	Call     string // The name of the API call, such as "UsersListCall".
+	Method   string
+	Endpoint string // The path of the endpoint without the base URL or query, such as "/users".
+	Attempt  int    // Zero for the first attempt and increased every time the request is sent again.
+	Cached   bool   // True if the response is served from the cache without sending the request to Twitch.
+}
+
+type requestInfoKey struct{}
+
+// RequestInfoFrom returns the information about the API call a request was sent for.
+func RequestInfoFrom(ctx context.Context) (RequestInfo, bool) {
+	info, ok := ctx.Value(requestInfoKey{}).(RequestInfo)
+	return info, ok
+}
+
+// newRequestInfo describes a request to the provided endpoint made for the named API call.
+func newRequestInfo(call, method, path string) RequestInfo {
+	endpoint := "/" + strings.TrimPrefix(strings.SplitN(path, "?", 2)[0], "/")
+	return RequestInfo{
+		Resource: strings.SplitN(strings.TrimPrefix(endpoint, "/"), "/", 2)[0],
+		Call:     call,
+		Method:   method,
+		Endpoint: endpoint,
+	}
+}
+
+// roundTrip sends a request through the middleware of the client, ending with the provided round trip.
+func (c *Client) roundTrip(req *http.Request, info RequestInfo, send RoundTripFunc) (*http.Response, error) {
+	req = req.WithContext(context.WithValue(req.Context(), requestInfoKey{}, info))
+	next := send
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		next = c.middleware[i](next)
+	}
+	res, err := next(req)
+	if err == nil && res.Request == nil {
+		res.Request = req
+	}
+	return res, err
+}
@@ -0,0 +1,97 @@
+package api_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/adeithe/go-twitch/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPI_Middleware(t *testing.T) {
+	var calls []string
+	var infos []api.RequestInfo
+	trace := func(name string) api.Middleware {
+		return func(next api.RoundTripFunc) api.RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name)
+				req.Header.Add("X-Middleware", name)
+				return next(req)
+			}
+		}
+	}
+	record := func(next api.RoundTripFunc) api.RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			info, ok := api.RequestInfoFrom(req.Context())
+			assert.True(t, ok)
+			infos = append(infos, info)
+			return next(req)
+		}
+	}
+
+	client := api.New("client-id", api.WithMiddleware(trace("outer"), trace("inner")), api.WithMiddleware(record), api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, []string{"outer", "inner"}, req.Header.Values("X-Middleware"))
+		return newMockResponse(http.StatusOK, `{"data":[]}`), nil
+	})))
+
+	_, err := client.Users.List().Do(context.Background())
+	assert.NoError(t, err)
+	_, err = client.ChannelPoints.CustomRewards.Redemption.List("123", "456").Do(context.Background())
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"outer", "inner", "outer", "inner"}, calls)
+	assert.Equal(t, []api.RequestInfo{
+		{Resource: "users", Call: "UsersListCall", Method: http.MethodGet, Endpoint: "/users"},
+		{Resource: "channel_points", Call: "CustomRewardsRedemptionListCall", Method: http.MethodGet, Endpoint: "/channel_points/custom_rewards/redemptions"},
+	}, infos)
+}
+
+func TestAPI_MiddlewareDryRun(t *testing.T) {
+	dryRun := func(next api.RoundTripFunc) api.RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			if req.Method == http.MethodGet {
+				return next(req)
+			}
+			return newMockResponse(http.StatusNoContent, ""), nil
+		}
+	}
+	client := api.New("client-id", api.WithMiddleware(dryRun), api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return nil, fmt.Errorf("unexpected %s request", req.Method)
+	})))
+
+	err := client.Whispers.Insert("123", "456").Message("Hello").Do(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestAPI_MiddlewareCache(t *testing.T) {
+	var sent int32
+	var infos []api.RequestInfo
+	record := func(next api.RoundTripFunc) api.RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			info, _ := api.RequestInfoFrom(req.Context())
+			infos = append(infos, info)
+			return next(req)
+		}
+	}
+	client := api.New("client-id", api.WithCache(nil), api.WithMiddleware(record), api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&sent, 1)
+		return newMockResponse(http.StatusOK, `{"data":[{"id":"1","login":"twitchdev"}]}`), nil
+	})))
+
+	for i := 0; i < 2; i++ {
+		res, err := client.Users.List().Login([]string{"twitchdev"}).Do(context.Background(), api.WithBearerToken("token"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Len(t, res.Data, 1)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&sent))
+	if assert.Len(t, infos, 2) {
+		assert.False(t, infos[0].Cached)
+		assert.True(t, infos[1].Cached)
+		assert.Equal(t, "UsersListCall", infos[1].Call)
+	}
+}
@@ -1,13 +1,20 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 )
 
 type ClientOption func(*Client)
 
 // WithClientSecret sets the client secret to use for API requests.
+//
+// When set, an app access token is requested, cached and refreshed automatically for any request that is not
+// given a token with WithBearerToken or WithTokenSource, unless a default token is set with WithDefaultBearerToken
+// or WithDefaultTokenSource.
 func WithClientSecret(secret string) ClientOption {
 	return func(c *Client) {
 		c.clientSecret = secret
@@ -19,9 +26,125 @@ func WithClientSecret(secret string) ClientOption {
 // This can be considered dangerous as if a token is not provided per request, this will become the default token.
 // Some developers may prefer to default to the App Access Token using this method. However, it is recommended to still
 // use the WithBearerToken option for requests that require a token as this method always will fail if the App Access Token has expired.
+// Use WithClientSecret instead to have the App Access Token refreshed automatically.
 func WithDefaultBearerToken(token string) ClientOption {
 	return func(c *Client) {
-		c.bearerToken = token
+		c.tokenSource = StaticTokenSource(&Token{AccessToken: token})
+	}
+}
+
+// WithDefaultTokenSource sets the token source to use for API requests that are not given a token.
+func WithDefaultTokenSource(source TokenSource) ClientOption {
+	return func(c *Client) {
+		c.tokenSource = source
+	}
+}
+
+// WithTokenValidation validates the default tokens with the Twitch authentication server before they are used.
+//
+// Twitch requires apps that use tokens for a long time to validate them every hour. Tokens are validated when they
+// are first used and again once the interval has passed. The interval defaults to 1 hour if it is not positive.
+// Tokens provided per request with WithTokenSource can be validated with NewValidatingTokenSource.
+func WithTokenValidation(interval time.Duration) ClientOption {
+	return func(c *Client) {
+		if interval <= 0 {
+			interval = defaultValidateInterval
+		}
+		c.validateInterval = interval
+	}
+}
+
+// WithRetryPolicy retries requests that fail with a transient error such as a 5xx response.
+//
+// Any field that is not set uses the value from DefaultRetryPolicy. By default only GET and DELETE requests are retried.
+//
+//	client := api.New(clientID, api.WithRetryPolicy(api.RetryPolicy{
+//		Methods: []string{http.MethodGet, http.MethodDelete, http.MethodPost},
+//	}))
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		if policy.MaxRetries <= 0 {
+			policy.MaxRetries = DefaultRetryPolicy.MaxRetries
+		}
+		if policy.MinBackoff <= 0 {
+			policy.MinBackoff = DefaultRetryPolicy.MinBackoff
+		}
+		if policy.MaxBackoff <= 0 {
+			policy.MaxBackoff = DefaultRetryPolicy.MaxBackoff
+		}
+		if len(policy.Methods) < 1 {
+			policy.Methods = DefaultRetryPolicy.Methods
+		}
+		c.retryPolicy = &policy
+	}
+}
+
+// WithBatchConcurrency sets how many requests are sent at the same time when a lookup is split into several requests.
+//
+// Twitch accepts up to 100 IDs or usernames per request, so larger lookups with calls such as UsersListCall.ID are
+// sent in batches. Default: 4
+func WithBatchConcurrency(n int) ClientOption {
+	return func(c *Client) {
+		c.batchConcurrency = n
+	}
+}
+
+// WithCache caches the responses of GET requests for slow-changing resources such as users and cheermotes.
+//
+// Responses are cached for the time set in DefaultCacheTTLs or with WithCacheTTL unless the Cache-Control header
+// of the response says otherwise. Expired responses with an ETag are checked with Twitch before being used again.
+// If the cache is nil, an in-memory LRU cache is used.
+func WithCache(cache Cache) ClientOption {
+	return func(c *Client) {
+		if cache == nil {
+			cache = NewLRUCache(defaultCacheSize)
+		}
+		c.cache = cache
+		if c.cacheTTLs == nil {
+			c.cacheTTLs = make(map[string]time.Duration)
+		}
+	}
+}
+
+// WithCacheTTL sets how long responses for the path are cached when a cache is set with WithCache.
+//
+// A TTL of zero disables caching for the path.
+//
+//	client := api.New(clientID, api.WithCache(nil), api.WithCacheTTL("/users", time.Hour))
+func WithCacheTTL(path string, ttl time.Duration) ClientOption {
+	return func(c *Client) {
+		if c.cacheTTLs == nil {
+			c.cacheTTLs = make(map[string]time.Duration)
+		}
+		c.cacheTTLs["/"+strings.TrimPrefix(path, "/")] = ttl
+	}
+}
+
+// WithMiddleware adds middleware that wraps the round trip of every request sent by the client.
+//
+// Middleware is called in the order it is added, so the first middleware sees the request first and the response last.
+// Every attempt of a request is passed through the middleware, including retries.
+func WithMiddleware(middleware ...Middleware) ClientOption {
+	return func(c *Client) {
+		c.middleware = append(c.middleware, middleware...)
+	}
+}
+
+// WithBaseURL sets the base URL for API requests, such as a proxy or a local mock of the Twitch API.
+//
+// Default: https://api.twitch.tv/helix
+func WithBaseURL(url string) ClientOption {
+	return func(c *Client) {
+		c.baseURL = strings.TrimSuffix(url, "/")
+	}
+}
+
+// WithAuthURL sets the base URL for requests to the Twitch authentication server.
+//
+// Default: https://id.twitch.tv/oauth2
+func WithAuthURL(url string) ClientOption {
+	return func(c *Client) {
+		c.authURL = strings.TrimSuffix(url, "/")
 	}
 }
 
@@ -32,6 +155,10 @@ func WithHTTPClient(client HTTPClient) ClientOption {
 	}
 }
 
+// RequestOption changes a request before it is sent.
+//
+// Calls that can be paginated apply the options passed to Do after the ones set with the methods of the call, so
+// that the cursor of each page replaces the one set with After.
 type RequestOption func(*http.Request)
 
 // WithBearerToken sets the bearer token to use for API requests.
@@ -41,6 +168,15 @@ func WithBearerToken(token string) RequestOption {
 	}
 }
 
+// WithTokenSource sets the token source to use for API requests.
+//
+// This is ignored if a token is also provided with WithBearerToken.
+func WithTokenSource(source TokenSource) RequestOption {
+	return func(req *http.Request) {
+		*req = *req.WithContext(context.WithValue(req.Context(), tokenSourceKey{}, source))
+	}
+}
+
 // SetQueryParameter sets a query parameter on the request, replacing any existing values.
 func SetQueryParameter(key, value string) RequestOption {
 	return func(r *http.Request) {
@@ -0,0 +1,115 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// ErrNoMorePages returned by Iterator.Next when every page has been read.
+var ErrNoMorePages = errors.New("twitchapi: no more pages")
+
+// PageFunc requests the page of results after the provided cursor and returns it with the cursor for the next page.
+//
+// The cursor is empty when requesting the first page.
+type PageFunc[T any] func(ctx context.Context, cursor string) (T, string, error)
+
+// Iterator follows the cursors of a list call one page at a time.
+//
+// Iteration stops when Twitch responds with an empty cursor or a cursor that has already been followed.
+//
+//	it := client.Streams.List().GameID([]string{"509658"}).Pages()
+//	for {
+//		page, err := it.Next(ctx)
+//		if err == api.ErrNoMorePages {
+//			break
+//		}
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(len(page.Data))
+//	}
+type Iterator[T any] struct {
+	fetch  PageFunc[T]
+	cursor string
+	seen   map[string]struct{}
+	done   bool
+}
+
+// NewIterator creates an Iterator that requests each page with the provided function.
+func NewIterator[T any](fetch PageFunc[T]) *Iterator[T] {
+	return &Iterator[T]{fetch: fetch, seen: make(map[string]struct{})}
+}
+
+// Next requests the next page of results.
+//
+// ErrNoMorePages is returned once there are no more pages.
+func (it *Iterator[T]) Next(ctx context.Context) (T, error) {
+	var page T
+	if it.done {
+		return page, ErrNoMorePages
+	}
+	page, cursor, err := it.fetch(ctx, it.cursor)
+	if err != nil {
+		return page, err
+	}
+	if _, ok := it.seen[cursor]; ok || len(cursor) < 1 {
+		it.done = true
+	}
+	it.seen[cursor] = struct{}{}
+	it.cursor = cursor
+	return page, nil
+}
+
+// Cursor returns the cursor for the next page of results.
+func (it *Iterator[T]) Cursor() string {
+	return it.cursor
+}
+
+// Done returns true if there are no more pages.
+func (it *Iterator[T]) Done() bool {
+	return it.done
+}
+
+// collect reads the items of every page until there are no more pages or the limit is reached.
+//
+// A limit less than 1 reads every page.
+func collect[P, T any](ctx context.Context, it *Iterator[P], limit int, items func(P) []T) ([]T, error) {
+	var data []T
+	for limit < 1 || len(data) < limit {
+		page, err := it.Next(ctx)
+		if err == ErrNoMorePages {
+			break
+		}
+		if err != nil {
+			return data, err
+		}
+		data = append(data, items(page)...)
+	}
+	if limit > 0 && len(data) > limit {
+		data = data[:limit]
+	}
+	return data, nil
+}
+
+// pages creates an iterator that calls do with the provided options and the cursor of each page of results.
+//
+// The cursor is added after the options, so it replaces any cursor set when the call was created.
+func pages[R any](do func(context.Context, ...RequestOption) (R, error), opts []RequestOption, cursor func(R) string) *Iterator[R] {
+	return NewIterator(func(ctx context.Context, after string) (R, string, error) {
+		res, err := do(ctx, joinOptions(opts, []RequestOption{afterCursor(after)})...)
+		if err != nil {
+			var zero R
+			return zero, "", err
+		}
+		return res, cursor(res), nil
+	})
+}
+
+// afterCursor sets the cursor for forward pagination if one is provided.
+func afterCursor(cursor string) RequestOption {
+	if len(cursor) < 1 {
+		return func(*http.Request) {}
+	}
+	return SetQueryParameter("after", cursor)
+}
@@ -0,0 +1,87 @@
+package api_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/adeithe/go-twitch/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func newPagedHTTPClient(t *testing.T, cursors map[string]string, sent *int32) api.HTTPClient {
+	return mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(sent, 1)
+		after := req.URL.Query().Get("after")
+		next, ok := cursors[after]
+		if !ok {
+			t.Fatalf("unexpected cursor %q", after)
+		}
+		return newMockResponse(http.StatusOK, fmt.Sprintf(`{"data":[{"id":"%s-1"},{"id":"%s-2"}],"pagination":{"cursor":%q}}`, after, after, next)), nil
+	})
+}
+
+func TestAPI_Pages(t *testing.T) {
+	var sent int32
+	client := api.New("client-id", api.WithHTTPClient(newPagedHTTPClient(t, map[string]string{"": "a", "a": "b", "b": ""}, &sent)))
+
+	it := client.Streams.List().First(2).Pages()
+	var ids []string
+	for {
+		page, err := it.Next(context.Background())
+		if err == api.ErrNoMorePages {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, stream := range page.Data {
+			ids = append(ids, stream.ID)
+		}
+	}
+	assert.Equal(t, []string{"-1", "-2", "a-1", "a-2", "b-1", "b-2"}, ids)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&sent))
+	assert.True(t, it.Done())
+}
+
+func TestAPI_PagesRepeatedCursor(t *testing.T) {
+	var sent int32
+	client := api.New("client-id", api.WithHTTPClient(newPagedHTTPClient(t, map[string]string{"": "a", "a": "a"}, &sent)))
+
+	clips, err := client.Clips.List().All(context.Background(), 0)
+	assert.NoError(t, err)
+	assert.Len(t, clips, 4)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&sent))
+}
+
+func TestAPI_AllLimit(t *testing.T) {
+	var sent int32
+	client := api.New("client-id", api.WithHTTPClient(newPagedHTTPClient(t, map[string]string{"": "a", "a": "b", "b": ""}, &sent)))
+
+	videos, err := client.Videos.List().All(context.Background(), 3)
+	assert.NoError(t, err)
+	if assert.Len(t, videos, 3) {
+		assert.Equal(t, "a-1", videos[2].ID)
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&sent))
+}
+
+func TestAPI_PagesAfterCursor(t *testing.T) {
+	var sent int32
+	client := api.New("client-id", api.WithHTTPClient(newPagedHTTPClient(t, map[string]string{"a": "b", "b": ""}, &sent)))
+
+	// The cursor of each page must replace the cursor set with After, or the first page would be requested again.
+	streams, err := client.Streams.List().After("a").All(context.Background(), 0)
+	assert.NoError(t, err)
+	if assert.Len(t, streams, 4) {
+		assert.Equal(t, "b-1", streams[2].ID)
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&sent))
+
+	// Options passed to Do are applied after the ones set with the methods of the call.
+	res, err := client.Games.Top.List().After("x").Do(context.Background(), api.SetQueryParameter("after", "b"))
+	assert.NoError(t, err)
+	assert.Equal(t, "b-1", res.Data[0].ID)
+}
@@ -0,0 +1,143 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Headers sent by Twitch with every API response to describe the rate limit of the token used
+const (
+	HeaderRatelimitLimit     = "Ratelimit-Limit"
+	HeaderRatelimitRemaining = "Ratelimit-Remaining"
+	HeaderRatelimitReset     = "Ratelimit-Reset"
+)
+
+// maxRateLimitRetries is how many times a request is sent again after being rate limited.
+const maxRateLimitRetries = 3
+
+// rateLimitPenalty is how long to wait after being rate limited when Twitch does not say when the bucket resets.
+const rateLimitPenalty = time.Second
+
+// rateLimitPruneInterval is how often buckets that have been reset are dropped.
+const rateLimitPruneInterval = time.Minute
+
+// rateLimiter tracks a separate bucket of points for every token used with the API.
+type rateLimiter struct {
+	buckets map[string]*rateBucket
+	pruned  time.Time
+	mx      sync.Mutex
+}
+
+type rateBucket struct {
+	limit     int
+	remaining int
+	reset     time.Time
+	mx        sync.Mutex
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{buckets: make(map[string]*rateBucket)}
+}
+
+// bucket returns the bucket for the provided key, creating it if needed.
+func (l *rateLimiter) bucket(key string) *rateBucket {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+	if b, ok := l.buckets[key]; ok {
+		return b
+	}
+	if now := time.Now(); now.Sub(l.pruned) >= rateLimitPruneInterval {
+		l.prune(now)
+		l.pruned = now
+	}
+	b := &rateBucket{}
+	l.buckets[key] = b
+	return b
+}
+
+// prune drops the buckets that have been reset, as they are full again, to stop old tokens from piling up.
+//
+// Buckets are checked at most once per prune interval so that creating buckets for many tokens stays cheap.
+func (l *rateLimiter) prune(now time.Time) {
+	for k, b := range l.buckets {
+		b.mx.Lock()
+		if b.reset.Before(now) {
+			delete(l.buckets, k)
+		}
+		b.mx.Unlock()
+	}
+}
+
+// take blocks until a point is available in the bucket and removes it.
+func (b *rateBucket) take(ctx context.Context) error {
+	for {
+		b.mx.Lock()
+		now := time.Now()
+		if !b.reset.After(now) {
+			b.remaining = b.limit
+			b.reset = time.Time{}
+		}
+		if b.limit == 0 || b.remaining > 0 || b.reset.IsZero() {
+			b.remaining--
+			b.mx.Unlock()
+			return nil
+		}
+		wait := b.reset.Sub(now)
+		b.mx.Unlock()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// update sets the state of the bucket from the headers of an API response.
+func (b *rateBucket) update(header http.Header) {
+	limit, err := strconv.Atoi(header.Get(HeaderRatelimitLimit))
+	if err != nil {
+		return
+	}
+	remaining, err := strconv.Atoi(header.Get(HeaderRatelimitRemaining))
+	if err != nil {
+		return
+	}
+	reset, err := strconv.ParseInt(header.Get(HeaderRatelimitReset), 10, 64)
+	if err != nil {
+		return
+	}
+	b.mx.Lock()
+	defer b.mx.Unlock()
+	b.limit = limit
+	b.remaining = remaining
+	b.reset = time.Unix(reset, 0)
+}
+
+// exhausted returns true if a 429 response was caused by the token running out of points rather than a limit
+// specific to the endpoint, such as starting too many raids.
+func exhausted(header http.Header) bool {
+	remaining, err := strconv.Atoi(header.Get(HeaderRatelimitRemaining))
+	return err != nil || remaining < 1
+}
+
+// exhaust empties the bucket after a request has been rate limited for at least the provided duration.
+func (b *rateBucket) exhaust(wait time.Duration) {
+	if wait <= 0 {
+		wait = rateLimitPenalty
+	}
+	b.mx.Lock()
+	defer b.mx.Unlock()
+	b.remaining = 0
+	if b.limit == 0 {
+		b.limit = 1
+	}
+	if reset := time.Now().Add(wait); b.reset.Before(reset) {
+		b.reset = reset
+	}
+}
@@ -0,0 +1,89 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/adeithe/go-twitch/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRateLimitedResponse(status int, body string, remaining int, reset time.Time) *http.Response {
+	res := newMockResponse(status, body)
+	res.Header.Set(api.HeaderRatelimitLimit, "800")
+	res.Header.Set(api.HeaderRatelimitRemaining, strconv.Itoa(remaining))
+	res.Header.Set(api.HeaderRatelimitReset, strconv.FormatInt(reset.Unix(), 10))
+	return res
+}
+
+func TestAPI_RateLimitRetry(t *testing.T) {
+	var sent int32
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		if atomic.AddInt32(&sent, 1) == 1 {
+			return newRateLimitedResponse(http.StatusTooManyRequests, `{"error":"Too Many Requests","status":429,"message":""}`, 0, time.Now()), nil
+		}
+		return newRateLimitedResponse(http.StatusOK, `{"data":[]}`, 799, time.Now().Add(time.Minute)), nil
+	})))
+
+	_, err := client.Users.List().Do(context.Background(), api.WithBearerToken("token"))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&sent))
+}
+
+func TestAPI_RateLimitBuckets(t *testing.T) {
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		if req.Header.Get("Authorization") == "Bearer app-token" {
+			return newRateLimitedResponse(http.StatusOK, `{"data":[]}`, 0, time.Now().Add(time.Minute)), nil
+		}
+		return newRateLimitedResponse(http.StatusOK, `{"data":[]}`, 799, time.Now().Add(time.Minute)), nil
+	})))
+
+	_, err := client.Users.List().Do(context.Background(), api.WithBearerToken("app-token"))
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+	_, err = client.Users.List().Do(ctx, api.WithBearerToken("app-token"))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	_, err = client.Users.List().Do(context.Background(), api.WithBearerToken("user-token"))
+	assert.NoError(t, err)
+}
+
+func TestAPI_RateLimitEndpointLimit(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Header   func(http.Header)
+		Expected int32
+	}{
+		{"BucketRemaining", func(header http.Header) {}, 1}, // A limit specific to the endpoint is returned to the caller.
+		{"BucketExhausted", func(header http.Header) { header.Set(api.HeaderRatelimitRemaining, "0") }, 2},
+		{"NoHeaders", func(header http.Header) { header.Del(api.HeaderRatelimitRemaining) }, 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var sent int32
+			client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+				if atomic.AddInt32(&sent, 1) == 1 {
+					res := newRateLimitedResponse(http.StatusTooManyRequests, `{"error":"Too Many Requests","status":429,"message":""}`, 799, time.Now())
+					test.Header(res.Header)
+					return res, nil
+				}
+				return newRateLimitedResponse(http.StatusOK, `{"data":[]}`, 799, time.Now().Add(time.Minute)), nil
+			})))
+
+			_, err := client.Users.List().Do(context.Background(), api.WithBearerToken("token"))
+			if test.Expected == 1 {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.Expected, atomic.LoadInt32(&sent))
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy describes how requests that fail with a transient error are retried.
+//
+// Requests are retried after a 5xx response, a connection reset or a timeout, waiting an exponentially
+// increasing amount of time with jitter between attempts.
+type RetryPolicy struct {
+	// MaxRetries is how many times a request may be retried. Default: 3
+	MaxRetries int
+	// MinBackoff is how long to wait before the first retry. Default: 500ms
+	MinBackoff time.Duration
+	// MaxBackoff is the longest time to wait between retries. Default: 30s
+	MaxBackoff time.Duration
+	// Methods are the HTTP methods that are safe to retry. Default: GET, DELETE
+	//
+	// Requests such as creating a clip or sending a whisper are not idempotent, so POST must be added explicitly.
+	Methods []string
+}
+
+// DefaultRetryPolicy is the policy used for any field that is not set with WithRetryPolicy.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	MinBackoff: time.Millisecond * 500,
+	MaxBackoff: time.Second * 30,
+	Methods:    []string{http.MethodGet, http.MethodDelete},
+}
+
+// retryable returns true if a request with the provided method may be retried after the given number of retries.
+func (p *RetryPolicy) retryable(method string, retries int) bool {
+	if p == nil || retries >= p.MaxRetries {
+		return false
+	}
+	for _, m := range p.Methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// retryStatus returns true if a response with the provided status should be retried.
+func (p *RetryPolicy) retryStatus(method string, status, retries int) bool {
+	if status < http.StatusInternalServerError || status == http.StatusNotImplemented {
+		return false
+	}
+	return p.retryable(method, retries)
+}
+
+// retryError returns true if a request that failed with the provided error should be retried.
+func (p *RetryPolicy) retryError(ctx context.Context, method string, err error, retries int) bool {
+	if ctx.Err() != nil || !p.retryable(method, retries) {
+		return false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// wait blocks for the backoff of the provided retry with up to half of it removed as jitter.
+func (p *RetryPolicy) wait(ctx context.Context, retries int) error {
+	backoff := p.MinBackoff
+	for i := 0; i < retries && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+	if backoff > 1 {
+		backoff -= time.Duration(rand.Int63n(int64(backoff / 2)))
+	}
+
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
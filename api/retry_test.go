@@ -0,0 +1,91 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/adeithe/go-twitch/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPI_RetryPolicy(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Methods  []string
+		Post     bool
+		Expected int32
+		Err      bool
+	}{
+		{"Get", nil, false, 3, false},
+		{"PostDefault", nil, true, 1, true},
+		{"PostOptIn", []string{http.MethodPost}, true, 3, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var sent int32
+			client := api.New("client-id", api.WithRetryPolicy(api.RetryPolicy{
+				MinBackoff: time.Millisecond,
+				MaxBackoff: time.Millisecond * 5,
+				Methods:    test.Methods,
+			}), api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+				switch atomic.AddInt32(&sent, 1) {
+				case 1:
+					return newMockResponse(http.StatusServiceUnavailable, `{"error":"Service Unavailable","status":503,"message":""}`), nil
+				case 2:
+					return nil, syscall.ECONNRESET
+				}
+				if req.Method == http.MethodPost {
+					return newMockResponse(http.StatusNoContent, ""), nil
+				}
+				return newMockResponse(http.StatusOK, `{"data":[]}`), nil
+			})))
+
+			var err error
+			if test.Post {
+				err = client.Whispers.Insert("123", "456").Message("Hello").Do(context.Background())
+			} else {
+				_, err = client.Users.List().Do(context.Background())
+			}
+			assert.Equal(t, test.Err, err != nil)
+			assert.Equal(t, test.Expected, atomic.LoadInt32(&sent))
+		})
+	}
+}
+
+func TestAPI_RetryPolicyMaxRetries(t *testing.T) {
+	var sent int32
+	client := api.New("client-id", api.WithRetryPolicy(api.RetryPolicy{MaxRetries: 2, MinBackoff: time.Millisecond}), api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&sent, 1)
+		return newMockResponse(http.StatusBadGateway, `{"error":"Bad Gateway","status":502,"message":""}`), nil
+	})))
+
+	_, err := client.Users.List().Do(context.Background())
+	assert.Equal(t, http.StatusBadGateway, api.CodeOf(err))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&sent))
+}
+
+func TestAPI_RetryPolicyRateLimit(t *testing.T) {
+	var sent int32
+	client := api.New("client-id", api.WithRetryPolicy(api.RetryPolicy{
+		MinBackoff: time.Millisecond,
+		MaxBackoff: time.Millisecond * 5,
+	}), api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		switch n := atomic.AddInt32(&sent, 1); {
+		case n <= 3:
+			return newMockResponse(http.StatusServiceUnavailable, `{"error":"Service Unavailable","status":503,"message":""}`), nil
+		case n == 4:
+			return newRateLimitedResponse(http.StatusTooManyRequests, `{"error":"Too Many Requests","status":429,"message":""}`, 0, time.Now()), nil
+		}
+		return newMockResponse(http.StatusOK, `{"data":[]}`), nil
+	})))
+
+	// Retrying the failures must not use up the retries of a rate limited request.
+	_, err := client.Users.List().Do(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int32(5), atomic.LoadInt32(&sent))
+}
@@ -66,7 +66,7 @@ func (c *AdsInsertRequest) Do(ctx context.Context, opts ...RequestOption) (*AdsI
 		return nil, err
 	}
 
-	res, err := c.client.doRequest(ctx, http.MethodPost, "/channels/commercial", bytes.NewReader(bs), opts...)
+	res, err := c.client.doRequest(ctx, "AdsInsertRequest", http.MethodPost, "/channels/commercial", bytes.NewReader(bs), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -127,7 +127,7 @@ func (r *AdsScheduleResource) List(broadcasterId string) *AdsScheduleListRequest
 
 // Do executes the request.
 func (r *AdsScheduleListRequest) Do(ctx context.Context, opts ...RequestOption) (*AdsScheduleListResponse, error) {
-	res, err := r.client.doRequest(ctx, http.MethodGet, "/channels/ads", nil, append(r.opts, opts...)...)
+	res, err := r.client.doRequest(ctx, "AdsScheduleListRequest", http.MethodGet, "/channels/ads", nil, append(r.opts, opts...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -183,7 +183,7 @@ func (r *AdsSnoozeResource) Insert(broadcasterId string) *AdsSnoozeRequest {
 
 // Do executes the request.
 func (r *AdsSnoozeRequest) Do(ctx context.Context, opts ...RequestOption) (*AdsSnoozeResponse, error) {
-	res, err := r.client.doRequest(ctx, http.MethodPost, "/channels/ads/schedule/snooze", nil, append(r.opts, opts...)...)
+	res, err := r.client.doRequest(ctx, "AdsSnoozeRequest", http.MethodPost, "/channels/ads/schedule/snooze", nil, append(r.opts, opts...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -96,7 +96,7 @@ func (r *AnalyticsExtensionListCall) After(cursor string) *AnalyticsExtensionLis
 
 // Do executes the request.
 func (r *AnalyticsExtensionListCall) Do(ctx context.Context, opts ...RequestOption) (*AnalyticsExtensionListResponse, error) {
-	res, err := r.client.doRequest(ctx, http.MethodGet, "/analytics/extensions", nil, append(r.opts, opts...)...)
+	res, err := r.client.doRequest(ctx, "AnalyticsExtensionListCall", http.MethodGet, "/analytics/extensions", nil, append(r.opts, opts...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -114,6 +114,18 @@ func (r *AnalyticsExtensionListCall) Do(ctx context.Context, opts ...RequestOpti
 	}, nil
 }
 
+// Pages creates an iterator over the pages of extension analytics reports.
+func (r *AnalyticsExtensionListCall) Pages(opts ...RequestOption) *Iterator[*AnalyticsExtensionListResponse] {
+	return pages(r.Do, opts, func(res *AnalyticsExtensionListResponse) string { return res.Pagination.Cursor })
+}
+
+// All returns the extension analytics reports from every page, up to the limit if it is greater than 0.
+func (r *AnalyticsExtensionListCall) All(ctx context.Context, limit int, opts ...RequestOption) ([]ExtensionAnalytics, error) {
+	return collect(ctx, r.Pages(opts...), limit, func(res *AnalyticsExtensionListResponse) []ExtensionAnalytics {
+		return res.Data
+	})
+}
+
 type AnalyticsGameResource struct {
 	client *Client
 }
@@ -176,7 +188,7 @@ func (r *AnalyticsGameListCall) After(a string) *AnalyticsGameListCall {
 
 // Do executes the request.
 func (r *AnalyticsGameListCall) Do(ctx context.Context, opts ...RequestOption) (*AnalyticsGameListResponse, error) {
-	res, err := r.client.doRequest(ctx, http.MethodGet, "/analytics/games", nil, append(r.opts, opts...)...)
+	res, err := r.client.doRequest(ctx, "AnalyticsGameListCall", http.MethodGet, "/analytics/games", nil, append(r.opts, opts...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -193,3 +205,15 @@ func (r *AnalyticsGameListCall) Do(ctx context.Context, opts ...RequestOption) (
 		Pagination: data.Pagination,
 	}, nil
 }
+
+// Pages creates an iterator over the pages of game analytics reports.
+func (r *AnalyticsGameListCall) Pages(opts ...RequestOption) *Iterator[*AnalyticsGameListResponse] {
+	return pages(r.Do, opts, func(res *AnalyticsGameListResponse) string { return res.Pagination.Cursor })
+}
+
+// All returns the game analytics reports from every page, up to the limit if it is greater than 0.
+func (r *AnalyticsGameListCall) All(ctx context.Context, limit int, opts ...RequestOption) ([]GameAnalytics, error) {
+	return collect(ctx, r.Pages(opts...), limit, func(res *AnalyticsGameListResponse) []GameAnalytics {
+		return res.Data
+	})
+}
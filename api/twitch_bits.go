@@ -82,7 +82,7 @@ func (c *CheermotesListCall) BroadcasterID(id string) *CheermotesListCall {
 
 // BroadcasterName filters the results to the specified broadcaster name.
 func (c *CheermotesListCall) Do(ctx context.Context, opts ...RequestOption) (*CheermotesListResponse, error) {
-	res, err := c.resource.client.doRequest(ctx, http.MethodGet, "/bits/cheermotes", nil, append(opts, c.opts...)...)
+	res, err := c.resource.client.doRequest(ctx, "CheermotesListCall", http.MethodGet, "/bits/cheermotes", nil, append(opts, c.opts...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -156,7 +156,7 @@ func (c *BitsLeaderboardListCall) UserID(userId string) *BitsLeaderboardListCall
 
 // Do executes the request.
 func (c *BitsLeaderboardListCall) Do(ctx context.Context, opts ...RequestOption) (*BitsLeaderboardListResponse, error) {
-	res, err := c.resource.client.doRequest(ctx, http.MethodGet, "/bits/leaderboard", nil, append(opts, c.opts...)...)
+	res, err := c.resource.client.doRequest(ctx, "BitsLeaderboardListCall", http.MethodGet, "/bits/leaderboard", nil, append(opts, c.opts...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -247,7 +247,7 @@ func (c *BitsTransactionsListCall) After(cursor string) *BitsTransactionsListCal
 }
 
 func (c *BitsTransactionsListCall) Do(ctx context.Context, opts ...RequestOption) (*BitsTransactionsListResponse, error) {
-	res, err := c.client.doRequest(ctx, http.MethodGet, "/extensions/transactions", nil, append(opts, c.opts...)...)
+	res, err := c.client.doRequest(ctx, "BitsTransactionsListCall", http.MethodGet, "/extensions/transactions", nil, append(c.opts, opts...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -264,3 +264,15 @@ func (c *BitsTransactionsListCall) Do(ctx context.Context, opts ...RequestOption
 		Pagination: data.Pagination,
 	}, nil
 }
+
+// Pages creates an iterator over the pages of extension transactions.
+func (c *BitsTransactionsListCall) Pages(opts ...RequestOption) *Iterator[*BitsTransactionsListResponse] {
+	return pages(c.Do, opts, func(res *BitsTransactionsListResponse) string { return res.Pagination.Cursor })
+}
+
+// All returns the extension transactions from every page, up to the limit if it is greater than 0.
+func (c *BitsTransactionsListCall) All(ctx context.Context, limit int, opts ...RequestOption) ([]ExtensionTransaction, error) {
+	return collect(ctx, c.Pages(opts...), limit, func(res *BitsTransactionsListResponse) []ExtensionTransaction {
+		return res.Data
+	})
+}
@@ -63,7 +63,9 @@ type CustomRewardsResource struct {
 }
 
 func NewCustomRewardsResource(client *Client) *CustomRewardsResource {
-	return &CustomRewardsResource{client: client}
+	r := &CustomRewardsResource{client: client}
+	r.Redemption = NewCustomRewardsRedemptionResource(client)
+	return r
 }
 
 type CustomRewardsListCall struct {
@@ -99,7 +101,7 @@ func (c *CustomRewardsListCall) OnlyManageable() *CustomRewardsListCall {
 
 // Do executes the request.
 func (c *CustomRewardsListCall) Do(ctx context.Context, opts ...RequestOption) (*CustomRewardsListResponse, error) {
-	res, err := c.resource.client.doRequest(ctx, http.MethodGet, "/channel_points/custom_rewards", nil, append(opts, c.opts...)...)
+	res, err := c.resource.client.doRequest(ctx, "CustomRewardsListCall", http.MethodGet, "/channel_points/custom_rewards", nil, append(opts, c.opts...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -210,7 +212,7 @@ func (c *CustomRewardsInsertCall) Do(ctx context.Context, opts ...RequestOption)
 		return nil, err
 	}
 
-	res, err := c.resource.client.doRequest(ctx, http.MethodPost, "/channel_points/custom_rewards", bytes.NewReader(bs), append(opts, c.opts...)...)
+	res, err := c.resource.client.doRequest(ctx, "CustomRewardsInsertCall", http.MethodPost, "/channel_points/custom_rewards", bytes.NewReader(bs), append(opts, c.opts...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -322,7 +324,7 @@ func (c *CustomRewardsUpdateCall) Do(ctx context.Context, opts ...RequestOption)
 		return nil, err
 	}
 
-	res, err := c.resource.client.doRequest(ctx, http.MethodPatch, "/channel_points/custom_rewards", bytes.NewReader(bs), append(opts, c.opts...)...)
+	res, err := c.resource.client.doRequest(ctx, "CustomRewardsUpdateCall", http.MethodPatch, "/channel_points/custom_rewards", bytes.NewReader(bs), append(opts, c.opts...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -353,7 +355,7 @@ func (r *CustomRewardsResource) Delete(broadcasterId, id string) *CustomRewardsD
 
 // Do executes the request.
 func (c *CustomRewardsDeleteCall) Do(ctx context.Context, opts ...RequestOption) error {
-	res, err := c.resource.client.doRequest(ctx, http.MethodDelete, "/channel_points/custom_rewards", nil, append(opts, c.opts...)...)
+	res, err := c.resource.client.doRequest(ctx, "CustomRewardsDeleteCall", http.MethodDelete, "/channel_points/custom_rewards", nil, append(opts, c.opts...)...)
 	if err != nil {
 		return err
 	}
@@ -429,7 +431,7 @@ func (c *CustomRewardsRedemptionListCall) After(cursor string) *CustomRewardsRed
 
 // Do executes the request.
 func (c *CustomRewardsRedemptionListCall) Do(ctx context.Context, opts ...RequestOption) (*CustomRewardsRedemptionListResponse, error) {
-	res, err := c.resource.client.doRequest(ctx, http.MethodGet, "/channel_points/custom_rewards/redemptions", nil, append(opts, c.opts...)...)
+	res, err := c.resource.client.doRequest(ctx, "CustomRewardsRedemptionListCall", http.MethodGet, "/channel_points/custom_rewards/redemptions", nil, append(c.opts, opts...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -447,6 +449,18 @@ func (c *CustomRewardsRedemptionListCall) Do(ctx context.Context, opts ...Reques
 	}, nil
 }
 
+// Pages creates an iterator over the pages of redemptions.
+func (c *CustomRewardsRedemptionListCall) Pages(opts ...RequestOption) *Iterator[*CustomRewardsRedemptionListResponse] {
+	return pages(c.Do, opts, func(res *CustomRewardsRedemptionListResponse) string { return res.Cursor })
+}
+
+// All returns the redemptions from every page, up to the limit if it is greater than 0.
+func (c *CustomRewardsRedemptionListCall) All(ctx context.Context, limit int, opts ...RequestOption) ([]CustomRewardRedemption, error) {
+	return collect(ctx, c.Pages(opts...), limit, func(res *CustomRewardsRedemptionListResponse) []CustomRewardRedemption {
+		return res.Data
+	})
+}
+
 type CustomRewardsRedemptionUpdateCall struct {
 	resource *CustomRewardsRedemptionResource
 	opts     []RequestOption
@@ -479,7 +493,7 @@ func (c *CustomRewardsRedemptionUpdateCall) Fulfill() *CustomRewardsRedemptionUp
 
 // Do executes the request.
 func (c *CustomRewardsRedemptionUpdateCall) Do(ctx context.Context, opts ...RequestOption) (*CustomRewardsRedemptionUpdateResponse, error) {
-	res, err := c.resource.client.doRequest(ctx, http.MethodPatch, "/channel_points/custom_rewards/redemptions", nil, append(opts, c.opts...)...)
+	res, err := c.resource.client.doRequest(ctx, "CustomRewardsRedemptionUpdateCall", http.MethodPatch, "/channel_points/custom_rewards/redemptions", nil, append(opts, c.opts...)...)
 	if err != nil {
 		return nil, err
 	}
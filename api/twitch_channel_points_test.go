@@ -0,0 +1,30 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/adeithe/go-twitch/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPI_CustomRewardsRedemptionList(t *testing.T) {
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/helix/channel_points/custom_rewards/redemptions", req.URL.Path)
+		assert.Equal(t, "1234", req.URL.Query().Get("broadcaster_id"))
+		assert.Equal(t, "reward-1", req.URL.Query().Get("reward_id"))
+		return newMockResponse(http.StatusOK, `{"data":[{"id":"redemption-1","user_input":"hello"}]}`), nil
+	})))
+
+	if !assert.NotNil(t, client.ChannelPoints.CustomRewards.Redemption) {
+		return
+	}
+	res, err := client.ChannelPoints.CustomRewards.Redemption.List("1234", "reward-1").Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, res.Data, 1) {
+		assert.Equal(t, "redemption-1", res.Data[0].ID)
+	}
+}
@@ -29,6 +29,7 @@ func NewChannelsResource(client *Client) *ChannelsResource {
 type ChannelsListCall struct {
 	resource *ChannelsResource
 	opts     []RequestOption
+	lookup   lookup
 }
 
 type ChannelsListResponse struct {
@@ -42,28 +43,34 @@ func (r *ChannelsResource) List() *ChannelsListCall {
 }
 
 // BroadcasterID filters the results to the specified broadcaster ID.
+//
+// Lookups of more than 100 IDs are split into several requests, each of which fetches every page of results.
 func (c *ChannelsListCall) BroadcasterID(ids []string) *ChannelsListCall {
-	for _, id := range ids {
-		c.opts = append(c.opts, AddQueryParameter("broadcaster_id", id))
-	}
+	c.lookup.add("broadcaster_id", ids...)
 	return c
 }
 
 // Do executes the request.
 func (c *ChannelsListCall) Do(ctx context.Context, opts ...RequestOption) (*ChannelsListResponse, error) {
-	res, err := c.resource.client.doRequest(ctx, http.MethodGet, "/channels", nil, append(opts, c.opts...)...)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
+	page, err := doBatched(ctx, c.resource.client, c.lookup, func(ctx context.Context, lookup []RequestOption) (*batchPage[Channel], error) {
+		res, err := c.resource.client.doRequest(ctx, "ChannelsListCall", http.MethodGet, "/channels", nil, joinOptions(c.opts, lookup, opts)...)
+		if err != nil {
+			return nil, err
+		}
+		defer res.Body.Close()
 
-	data, err := decodeResponse[Channel](res)
+		data, err := decodeResponse[Channel](res)
+		if err != nil {
+			return nil, err
+		}
+		return &batchPage[Channel]{Header: res.Header, Data: data.Data, Cursor: data.Pagination.Cursor}, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	return &ChannelsListResponse{
-		Header: res.Header,
-		Data:   data.Data,
+		Header: page.Header,
+		Data:   page.Data,
 	}, nil
 }
@@ -12,15 +12,50 @@ type Chatter struct {
 	DisplayName string `json:"user_name"`
 }
 
+type ChatEmote struct {
+	ID        string         `json:"id"`
+	Name      string         `json:"name"`
+	Images    ChatEmoteImage `json:"images"`
+	Format    []string       `json:"format"`     // Possible values: "static", "animated"
+	Scale     []string       `json:"scale"`      // Possible values: "1.0", "2.0", "3.0"
+	ThemeMode []string       `json:"theme_mode"` // Possible values: "light", "dark"
+}
+
+type ChatEmoteImage struct {
+	URL1x string `json:"url_1x"`
+	URL2x string `json:"url_2x"`
+	URL4x string `json:"url_4x"`
+}
+
+type ChatBadgeSet struct {
+	SetID    string             `json:"set_id"`
+	Versions []ChatBadgeVersion `json:"versions"`
+}
+
+type ChatBadgeVersion struct {
+	ID          string `json:"id"`
+	ImageURL1x  string `json:"image_url_1x"`
+	ImageURL2x  string `json:"image_url_2x"`
+	ImageURL4x  string `json:"image_url_4x"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	ClickAction string `json:"click_action"`
+	ClickURL    string `json:"click_url"`
+}
+
 type ChatResource struct {
 	client *Client
 
 	Chatters *ChattersResource
+	Emotes   *ChatEmotesResource
+	Badges   *ChatBadgesResource
 }
 
 func NewChatResource(client *Client) *ChatResource {
 	r := &ChatResource{client: client}
 	r.Chatters = NewChattersResource(client)
+	r.Emotes = NewChatEmotesResource(client)
+	r.Badges = NewChatBadgesResource(client)
 	return r
 }
 
@@ -68,7 +103,7 @@ func (c *ChattersListCall) After(cursor string) *ChattersListCall {
 }
 
 func (c *ChattersListCall) Do(ctx context.Context, opts ...RequestOption) (*ChattersResponse, error) {
-	res, err := c.resource.client.doRequest(ctx, http.MethodGet, "/chat/chatters", nil, append(c.opts, opts...)...)
+	res, err := c.resource.client.doRequest(ctx, "ChattersListCall", http.MethodGet, "/chat/chatters", nil, append(c.opts, opts...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -86,3 +121,101 @@ func (c *ChattersListCall) Do(ctx context.Context, opts ...RequestOption) (*Chat
 		Cursor:   data.Pagination.Cursor,
 	}, nil
 }
+
+// Pages creates an iterator over the pages of chatters.
+func (c *ChattersListCall) Pages(opts ...RequestOption) *Iterator[*ChattersResponse] {
+	return pages(c.Do, opts, func(res *ChattersResponse) string { return res.Cursor })
+}
+
+// All returns the chatters from every page, up to the limit if it is greater than 0.
+func (c *ChattersListCall) All(ctx context.Context, limit int, opts ...RequestOption) ([]Chatter, error) {
+	return collect(ctx, c.Pages(opts...), limit, func(res *ChattersResponse) []Chatter {
+		return res.Chatters
+	})
+}
+
+type ChatEmotesResource struct {
+	client *Client
+}
+
+func NewChatEmotesResource(client *Client) *ChatEmotesResource {
+	return &ChatEmotesResource{client: client}
+}
+
+type ChatEmotesGlobalCall struct {
+	resource *ChatEmotesResource
+	opts     []RequestOption
+}
+
+type ChatEmotesResponse struct {
+	Header   http.Header
+	Data     []ChatEmote
+	Template string // A URL template for emote images, such as "https://static-cdn.jtvnw.net/emoticons/v2/{{id}}/{{format}}/{{theme_mode}}/{{scale}}".
+}
+
+// Global creates a request to list the global emotes that every user can use in chat.
+func (r *ChatEmotesResource) Global() *ChatEmotesGlobalCall {
+	return &ChatEmotesGlobalCall{resource: r}
+}
+
+// Do executes the request.
+func (c *ChatEmotesGlobalCall) Do(ctx context.Context, opts ...RequestOption) (*ChatEmotesResponse, error) {
+	res, err := c.resource.client.doRequest(ctx, "ChatEmotesGlobalCall", http.MethodGet, "/chat/emotes/global", nil, append(c.opts, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	data, err := decodeResponse[ChatEmote](res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChatEmotesResponse{
+		Header:   res.Header,
+		Data:     data.Data,
+		Template: data.Template,
+	}, nil
+}
+
+type ChatBadgesResource struct {
+	client *Client
+}
+
+func NewChatBadgesResource(client *Client) *ChatBadgesResource {
+	return &ChatBadgesResource{client: client}
+}
+
+type ChatBadgesGlobalCall struct {
+	resource *ChatBadgesResource
+	opts     []RequestOption
+}
+
+type ChatBadgesResponse struct {
+	Header http.Header
+	Data   []ChatBadgeSet
+}
+
+// Global creates a request to list the global chat badges, such as the moderator and subscriber badges.
+func (r *ChatBadgesResource) Global() *ChatBadgesGlobalCall {
+	return &ChatBadgesGlobalCall{resource: r}
+}
+
+// Do executes the request.
+func (c *ChatBadgesGlobalCall) Do(ctx context.Context, opts ...RequestOption) (*ChatBadgesResponse, error) {
+	res, err := c.resource.client.doRequest(ctx, "ChatBadgesGlobalCall", http.MethodGet, "/chat/badges/global", nil, append(c.opts, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	data, err := decodeResponse[ChatBadgeSet](res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChatBadgesResponse{
+		Header: res.Header,
+		Data:   data.Data,
+	}, nil
+}
@@ -41,6 +41,7 @@ func NewClipsResource(client *Client) *ClipsResource {
 type ClipsListCall struct {
 	resource *ClipsResource
 	opts     []RequestOption
+	lookup   lookup
 }
 
 type ClipsListResponse struct {
@@ -57,10 +58,10 @@ func (r *ClipsResource) List() *ClipsListCall {
 }
 
 // ID filters the results to those with the specified clip IDs.
+//
+// Lookups of more than 100 IDs are split into several requests, each of which fetches every page of results.
 func (c *ClipsListCall) ID(ids []string) *ClipsListCall {
-	for _, id := range ids {
-		c.opts = append(c.opts, AddQueryParameter("id", id))
-	}
+	c.lookup.add("id", ids...)
 	return c
 }
 
@@ -114,24 +115,42 @@ func (c *ClipsListCall) Featured() *ClipsListCall {
 
 // Do executes the call.
 func (c *ClipsListCall) Do(ctx context.Context, opts ...RequestOption) (*ClipsListResponse, error) {
-	res, err := c.resource.client.doRequest(ctx, http.MethodGet, "/clips", nil, append(c.opts, opts...)...)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	data, err := decodeResponse[Clip](res)
+	page, err := doBatched(ctx, c.resource.client, c.lookup, func(ctx context.Context, lookup []RequestOption) (*batchPage[Clip], error) {
+		res, err := c.resource.client.doRequest(ctx, "ClipsListCall", http.MethodGet, "/clips", nil, joinOptions(c.opts, lookup, opts)...)
+		if err != nil {
+			return nil, err
+		}
+		defer res.Body.Close()
+
+		data, err := decodeResponse[Clip](res)
+		if err != nil {
+			return nil, err
+		}
+		return &batchPage[Clip]{Header: res.Header, Data: data.Data, Cursor: data.Pagination.Cursor}, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	return &ClipsListResponse{
-		Header: res.Header,
-		Data:   data.Data,
-		Cursor: data.Pagination.Cursor,
+		Header: page.Header,
+		Data:   page.Data,
+		Cursor: page.Cursor,
 	}, nil
 }
 
+// Pages creates an iterator over the pages of clips.
+func (c *ClipsListCall) Pages(opts ...RequestOption) *Iterator[*ClipsListResponse] {
+	return pages(c.Do, opts, func(res *ClipsListResponse) string { return res.Cursor })
+}
+
+// All returns the clips from every page, up to the limit if it is greater than 0.
+func (c *ClipsListCall) All(ctx context.Context, limit int, opts ...RequestOption) ([]Clip, error) {
+	return collect(ctx, c.Pages(opts...), limit, func(res *ClipsListResponse) []Clip {
+		return res.Data
+	})
+}
+
 func (d *ClipDuration) UnmarshalJSON(data []byte) error {
 	var duration float64
 	if err := json.Unmarshal(data, &duration); err != nil {
@@ -15,19 +15,28 @@ type ConduitShard struct {
 	Transport Transport `json:"transport"`
 }
 
+// ConduitShardError is a shard that Twitch could not update.
+type ConduitShardError struct {
+	ID      string `json:"id"`
+	Message string `json:"message"`
+	Code    string `json:"code"`
+}
+
 // ConduitShardsResponse represents the response from the Twitch Eventsub Conduit Shards API.
 type ConduitShardsResponse struct {
 	Header http.Header
 	Shards []ConduitShard
+	Errors []ConduitShardError // Only present after updating shards.
 	Cursor string
 }
 
-// Transport is the transport method for a Twitch Eventsub Conduit Shard.
+// Transport is the transport method for a Twitch Eventsub subscription or Conduit Shard.
 type Transport struct {
 	Method         string     `json:"method"`
 	Callback       *string    `json:"callback,omitempty"`
 	Secret         *string    `json:"secret,omitempty"`
 	SessionID      *string    `json:"session_id,omitempty"`
+	ConduitID      *string    `json:"conduit_id,omitempty"`
 	ConnectedAt    *time.Time `json:"connected_at,omitempty"`
 	DisconnectedAt *time.Time `json:"disconnected_at,omitempty"`
 }
@@ -49,6 +58,14 @@ func NewWebSocketTransport(sessionID string) Transport {
 	}
 }
 
+// NewConduitTransport creates a new conduit transport for a Twitch Eventsub subscription.
+func NewConduitTransport(conduitID string) Transport {
+	return Transport{
+		Method:    "conduit",
+		ConduitID: &conduitID,
+	}
+}
+
 // ConduitShardsResource is the API resource for managing Twitch Eventsub Conduit Shards.
 type ConduitShardsResource struct {
 	client *Client
@@ -89,7 +106,7 @@ func (c *ConduitShardListCall) After(cursor string) *ConduitShardListCall {
 
 // Do executes the request.
 func (c *ConduitShardListCall) Do(ctx context.Context, opts ...RequestOption) (*ConduitShardsResponse, error) {
-	res, err := c.resource.client.doRequest(ctx, http.MethodGet, "/eventsub/conduits/shards", nil, opts...)
+	res, err := c.resource.client.doRequest(ctx, "ConduitShardListCall", http.MethodGet, "/eventsub/conduits/shards", nil, append(c.opts, opts...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -107,6 +124,18 @@ func (c *ConduitShardListCall) Do(ctx context.Context, opts ...RequestOption) (*
 	}, nil
 }
 
+// Pages creates an iterator over the pages of shards.
+func (c *ConduitShardListCall) Pages(opts ...RequestOption) *Iterator[*ConduitShardsResponse] {
+	return pages(c.Do, opts, func(res *ConduitShardsResponse) string { return res.Cursor })
+}
+
+// All returns the shards from every page, up to the limit if it is greater than 0.
+func (c *ConduitShardListCall) All(ctx context.Context, limit int, opts ...RequestOption) ([]ConduitShard, error) {
+	return collect(ctx, c.Pages(opts...), limit, func(res *ConduitShardsResponse) []ConduitShard {
+		return res.Shards
+	})
+}
+
 // ConduitShardUpdateCall is the API call for updating Twitch Eventsub Conduit Shards.
 type ConduitShardUpdateCall struct {
 	resource  *ConduitShardsResource
@@ -141,7 +170,7 @@ func (c *ConduitShardUpdateCall) Do(ctx context.Context, opts ...RequestOption)
 		return nil, err
 	}
 
-	res, err := c.resource.client.doRequest(ctx, http.MethodPatch, "/eventsub/conduits/shards", bytes.NewReader(bs), opts...)
+	res, err := c.resource.client.doRequest(ctx, "ConduitShardUpdateCall", http.MethodPatch, "/eventsub/conduits/shards", bytes.NewReader(bs), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -152,9 +181,17 @@ func (c *ConduitShardUpdateCall) Do(ctx context.Context, opts ...RequestOption)
 		return nil, err
 	}
 
+	var shardErrors []ConduitShardError
+	if len(data.Errors) > 0 {
+		if err := json.Unmarshal(data.Errors, &shardErrors); err != nil {
+			return nil, err
+		}
+	}
+
 	return &ConduitShardsResponse{
 		Header: res.Header,
 		Shards: data.Data,
+		Errors: shardErrors,
 		Cursor: data.Pagination.Cursor,
 	}, nil
 }
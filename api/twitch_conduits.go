@@ -44,7 +44,7 @@ func (r *ConduitsResource) List() *ConduitsListCall {
 
 // Do executes the request.
 func (c *ConduitsListCall) Do(ctx context.Context, opts ...RequestOption) (*ConduitsResponse, error) {
-	res, err := c.resource.client.doRequest(ctx, http.MethodGet, "/eventsub/conduits", nil, opts...)
+	res, err := c.resource.client.doRequest(ctx, "ConduitsListCall", http.MethodGet, "/eventsub/conduits", nil, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -85,7 +85,7 @@ func (c *ConduitInsertCall) Do(ctx context.Context, opts ...RequestOption) (*Con
 		return nil, err
 	}
 
-	res, err := c.resource.client.doRequest(ctx, http.MethodPost, "/eventsub/conduits", bytes.NewReader(bs), opts...)
+	res, err := c.resource.client.doRequest(ctx, "ConduitInsertCall", http.MethodPost, "/eventsub/conduits", bytes.NewReader(bs), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +126,7 @@ func (c *ConduitUpdateCall) Do(ctx context.Context, opts ...RequestOption) (*Con
 		return nil, err
 	}
 
-	res, err := c.resource.client.doRequest(ctx, http.MethodPatch, "/eventsub/conduits", bytes.NewReader(bs), opts...)
+	res, err := c.resource.client.doRequest(ctx, "ConduitUpdateCall", http.MethodPatch, "/eventsub/conduits", bytes.NewReader(bs), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -161,7 +161,7 @@ func (r *ConduitsResource) Delete(id string) *ConduitDeleteCall {
 
 // Do executes the request.
 func (c *ConduitDeleteCall) Do(ctx context.Context, opts ...RequestOption) error {
-	res, err := c.resource.client.doRequest(ctx, http.MethodDelete, "/eventsub/conduits", nil, append(c.opts, opts...)...)
+	res, err := c.resource.client.doRequest(ctx, "ConduitDeleteCall", http.MethodDelete, "/eventsub/conduits", nil, append(c.opts, opts...)...)
 	if err != nil {
 		return err
 	}
@@ -1,9 +1,208 @@
 package api
 
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// EventSubSubscription represents a Twitch EventSub subscription.
+type EventSubSubscription struct {
+	ID        string            `json:"id"`
+	Status    string            `json:"status"`
+	Type      string            `json:"type"`
+	Version   string            `json:"version"`
+	Condition map[string]string `json:"condition"`
+	Transport Transport         `json:"transport"`
+	Cost      int               `json:"cost"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+// EventSubSubscriptionsResponse represents the response from the Twitch EventSub Subscriptions API.
+type EventSubSubscriptionsResponse struct {
+	Header        http.Header
+	Subscriptions []EventSubSubscription
+	Total         int
+	TotalCost     int
+	MaxTotalCost  int
+	Cursor        string
+}
+
+// EventSubResource is the API resource for managing Twitch EventSub subscriptions.
 type EventSubResource struct {
 	client *Client
 }
 
+// NewEventSubResource creates a new EventSubResource.
 func NewEventSubResource(client *Client) *EventSubResource {
 	return &EventSubResource{client}
 }
+
+// EventSubListCall is the API call for listing Twitch EventSub subscriptions.
+type EventSubListCall struct {
+	resource *EventSubResource
+	opts     []RequestOption
+}
+
+// List creates a new EventSubListCall.
+//
+// Subscriptions using the webhook or conduit transport require an app access token.
+// Subscriptions using the websocket transport require the user access token that created them.
+//
+// Only one of Status, Type or UserID may be specified.
+func (r *EventSubResource) List() *EventSubListCall {
+	return &EventSubListCall{resource: r}
+}
+
+// Status filters the list of subscriptions by status.
+//
+// Possible values: "enabled", "webhook_callback_verification_pending", "webhook_callback_verification_failed",
+// "notification_failures_exceeded", "authorization_revoked", "moderator_removed", "user_removed", "version_removed",
+// "beta_maintenance", "websocket_disconnected", "websocket_failed_ping_pong", "websocket_received_inbound_traffic",
+// "websocket_connection_unused", "websocket_internal_error", "websocket_network_timeout", "websocket_network_error"
+func (c *EventSubListCall) Status(status string) *EventSubListCall {
+	c.opts = append(c.opts, SetQueryParameter("status", status))
+	return c
+}
+
+// Type filters the list of subscriptions by subscription type. For example, "channel.follow".
+func (c *EventSubListCall) Type(t string) *EventSubListCall {
+	c.opts = append(c.opts, SetQueryParameter("type", t))
+	return c
+}
+
+// UserID filters the list of subscriptions to those with a condition that references the specified user ID.
+func (c *EventSubListCall) UserID(id string) *EventSubListCall {
+	c.opts = append(c.opts, SetQueryParameter("user_id", id))
+	return c
+}
+
+// After sets the cursor for pagination.
+func (c *EventSubListCall) After(cursor string) *EventSubListCall {
+	c.opts = append(c.opts, SetQueryParameter("after", cursor))
+	return c
+}
+
+// Do executes the request.
+func (c *EventSubListCall) Do(ctx context.Context, opts ...RequestOption) (*EventSubSubscriptionsResponse, error) {
+	res, err := c.resource.client.doRequest(ctx, "EventSubListCall", http.MethodGet, "/eventsub/subscriptions", nil, append(c.opts, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	data, err := decodeResponse[EventSubSubscription](res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventSubSubscriptionsResponse{
+		Header:        res.Header,
+		Subscriptions: data.Data,
+		Total:         data.Total,
+		TotalCost:     data.TotalCost,
+		MaxTotalCost:  data.MaxTotalCost,
+		Cursor:        data.Pagination.Cursor,
+	}, nil
+}
+
+// Pages creates an iterator over the pages of subscriptions.
+func (c *EventSubListCall) Pages(opts ...RequestOption) *Iterator[*EventSubSubscriptionsResponse] {
+	return pages(c.Do, opts, func(res *EventSubSubscriptionsResponse) string { return res.Cursor })
+}
+
+// All returns the subscriptions from every page, up to the limit if it is greater than 0.
+func (c *EventSubListCall) All(ctx context.Context, limit int, opts ...RequestOption) ([]EventSubSubscription, error) {
+	return collect(ctx, c.Pages(opts...), limit, func(res *EventSubSubscriptionsResponse) []EventSubSubscription {
+		return res.Subscriptions
+	})
+}
+
+// EventSubInsertCall is the API call for creating a Twitch EventSub subscription.
+type EventSubInsertCall struct {
+	resource *EventSubResource
+	body     map[string]interface{}
+}
+
+// Insert creates a new EventSubInsertCall.
+//
+// Subscriptions using the webhook or conduit transport require an app access token.
+// Subscriptions using the websocket transport require a user access token.
+func (r *EventSubResource) Insert(subscriptionType, version string, transport Transport) *EventSubInsertCall {
+	return &EventSubInsertCall{
+		resource: r,
+		body: map[string]interface{}{
+			"type":      subscriptionType,
+			"version":   version,
+			"condition": make(map[string]string),
+			"transport": transport,
+		},
+	}
+}
+
+// Condition sets a condition the subscription will be created with. For example, "broadcaster_user_id".
+//
+// The required conditions depend on the subscription type.
+func (c *EventSubInsertCall) Condition(key, value string) *EventSubInsertCall {
+	c.body["condition"].(map[string]string)[key] = value
+	return c
+}
+
+// Do executes the request.
+func (c *EventSubInsertCall) Do(ctx context.Context, opts ...RequestOption) (*EventSubSubscriptionsResponse, error) {
+	bs, err := json.Marshal(c.body)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.resource.client.doRequest(ctx, "EventSubInsertCall", http.MethodPost, "/eventsub/subscriptions", bytes.NewReader(bs), opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	data, err := decodeResponse[EventSubSubscription](res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventSubSubscriptionsResponse{
+		Header:        res.Header,
+		Subscriptions: data.Data,
+		Total:         data.Total,
+		TotalCost:     data.TotalCost,
+		MaxTotalCost:  data.MaxTotalCost,
+	}, nil
+}
+
+// EventSubDeleteCall is the API call for deleting a Twitch EventSub subscription.
+type EventSubDeleteCall struct {
+	resource *EventSubResource
+	opts     []RequestOption
+}
+
+// Delete creates a new EventSubDeleteCall.
+func (r *EventSubResource) Delete(id string) *EventSubDeleteCall {
+	return &EventSubDeleteCall{
+		resource: r,
+		opts: []RequestOption{
+			SetQueryParameter("id", id),
+		},
+	}
+}
+
+// Do executes the request.
+func (c *EventSubDeleteCall) Do(ctx context.Context, opts ...RequestOption) error {
+	res, err := c.resource.client.doRequest(ctx, "EventSubDeleteCall", http.MethodDelete, "/eventsub/subscriptions", nil, append(c.opts, opts...)...)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if _, err := decodeResponse[any](res); err != nil {
+		return err
+	}
+	return nil
+}
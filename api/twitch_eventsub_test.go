@@ -0,0 +1,83 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/adeithe/go-twitch/api"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockHTTPClient func(*http.Request) (*http.Response, error)
+
+func (f mockHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func newMockResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     make(http.Header),
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestAPI_EventSubList(t *testing.T) {
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodGet, req.Method)
+		assert.Equal(t, "/helix/eventsub/subscriptions", req.URL.Path)
+		assert.Equal(t, "enabled", req.URL.Query().Get("status"))
+		assert.Equal(t, "abc", req.URL.Query().Get("after"))
+		return newMockResponse(http.StatusOK, `{"total":2,"total_cost":1,"max_total_cost":10000,"data":[{"id":"1","status":"enabled","type":"channel.follow","version":"2","condition":{"broadcaster_user_id":"1234"},"transport":{"method":"conduit","conduit_id":"c1"},"cost":1}],"pagination":{"cursor":"def"}}`), nil
+	})))
+
+	res, err := client.EventSub.List().Status("enabled").After("abc").Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 2, res.Total)
+	assert.Equal(t, 1, res.TotalCost)
+	assert.Equal(t, 10000, res.MaxTotalCost)
+	assert.Equal(t, "def", res.Cursor)
+	assert.Len(t, res.Subscriptions, 1)
+	assert.Equal(t, "1234", res.Subscriptions[0].Condition["broadcaster_user_id"])
+	assert.Equal(t, "c1", *res.Subscriptions[0].Transport.ConduitID)
+}
+
+func TestAPI_EventSubInsert(t *testing.T) {
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodPost, req.Method)
+		var body map[string]interface{}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "stream.online", body["type"])
+		assert.Equal(t, "1", body["version"])
+		assert.Equal(t, map[string]interface{}{"broadcaster_user_id": "1234"}, body["condition"])
+		assert.Equal(t, map[string]interface{}{"method": "websocket", "session_id": "session"}, body["transport"])
+		return newMockResponse(http.StatusAccepted, `{"total":1,"total_cost":0,"max_total_cost":10,"data":[{"id":"1","status":"enabled","type":"stream.online","version":"1"}]}`), nil
+	})))
+
+	res, err := client.EventSub.Insert("stream.online", "1", api.NewWebSocketTransport("session")).
+		Condition("broadcaster_user_id", "1234").
+		Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 10, res.MaxTotalCost)
+	assert.Equal(t, "enabled", res.Subscriptions[0].Status)
+}
+
+func TestAPI_EventSubDelete(t *testing.T) {
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodDelete, req.Method)
+		assert.Equal(t, "1", req.URL.Query().Get("id"))
+		return newMockResponse(http.StatusNoContent, ""), nil
+	})))
+
+	assert.NoError(t, client.EventSub.Delete("1").Do(context.Background()))
+}
@@ -25,6 +25,66 @@ func NewGamesResource(client *Client) *GamesResource {
 	return c
 }
 
+type GamesListCall struct {
+	resource *GamesResource
+	opts     []RequestOption
+}
+
+type GamesListResponse struct {
+	Header http.Header
+	Data   []Game
+}
+
+// List creates a request to get games and categories by their ID, name or IGDB ID.
+//
+// Up to 100 IDs, names and IGDB IDs combined may be specified.
+func (r *GamesResource) List() *GamesListCall {
+	return &GamesListCall{resource: r}
+}
+
+// ID filters the results to the specified game IDs.
+func (c *GamesListCall) ID(ids []string) *GamesListCall {
+	for _, id := range ids {
+		c.opts = append(c.opts, AddQueryParameter("id", id))
+	}
+	return c
+}
+
+// Name filters the results to games with exactly the specified names, such as "Science & Technology".
+func (c *GamesListCall) Name(names []string) *GamesListCall {
+	for _, name := range names {
+		c.opts = append(c.opts, AddQueryParameter("name", name))
+	}
+	return c
+}
+
+// IGDBID filters the results to the specified IGDB IDs.
+func (c *GamesListCall) IGDBID(ids []string) *GamesListCall {
+	for _, id := range ids {
+		c.opts = append(c.opts, AddQueryParameter("igdb_id", id))
+	}
+	return c
+}
+
+// Do executes the request.
+func (c *GamesListCall) Do(ctx context.Context, opts ...RequestOption) (*GamesListResponse, error) {
+	res, err := c.resource.client.doRequest(ctx, "GamesListCall", http.MethodGet, "/games", nil, append(c.opts, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	data, err := decodeResponse[Game](res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GamesListResponse{
+		Header: res.Header,
+		Data:   data.Data,
+	}, nil
+}
+
 type TopGamesResource struct {
 	client *Client
 }
@@ -71,7 +131,7 @@ func (c *TopGamesListCall) After(cursor string) *TopGamesListCall {
 
 // Do executes the request.
 func (c *TopGamesListCall) Do(ctx context.Context, opts ...RequestOption) (*TopGamesListResponse, error) {
-	res, err := c.resource.client.doRequest(ctx, http.MethodGet, "/games/top", nil, append(opts, c.opts...)...)
+	res, err := c.resource.client.doRequest(ctx, "TopGamesListCall", http.MethodGet, "/games/top", nil, append(c.opts, opts...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -88,3 +148,15 @@ func (c *TopGamesListCall) Do(ctx context.Context, opts ...RequestOption) (*TopG
 		Cursor: data.Pagination.Cursor,
 	}, nil
 }
+
+// Pages creates an iterator over the pages of games.
+func (c *TopGamesListCall) Pages(opts ...RequestOption) *Iterator[*TopGamesListResponse] {
+	return pages(c.Do, opts, func(res *TopGamesListResponse) string { return res.Cursor })
+}
+
+// All returns the games from every page, up to the limit if it is greater than 0.
+func (c *TopGamesListCall) All(ctx context.Context, limit int, opts ...RequestOption) ([]Game, error) {
+	return collect(ctx, c.Pages(opts...), limit, func(res *TopGamesListResponse) []Game {
+		return res.Data
+	})
+}
@@ -82,7 +82,7 @@ func (c *CreateBanRequest) Do(ctx context.Context, opts ...RequestOption) ([]Cha
 	query := url.Values{}
 	query.Set("broadcaster_id", c.broadcasterID)
 	query.Set("moderator_id", c.moderatorID)
-	res, err := c.resource.client.doRequest(ctx, http.MethodPost, fmt.Sprintf("/moderation/bans?%s", query.Encode()), bytes.NewReader(bs), opts...)
+	res, err := c.resource.client.doRequest(ctx, "CreateBanRequest", http.MethodPost, fmt.Sprintf("/moderation/bans?%s", query.Encode()), bytes.NewReader(bs), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -122,7 +122,7 @@ func (c *RemoveBanRequest) Do(ctx context.Context, opts ...RequestOption) error
 	query.Set("moderator_id", c.moderatorID)
 	query.Set("user_id", c.userID)
 
-	res, err := c.resource.client.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/moderation/bans?%s", query.Encode()), nil, opts...)
+	res, err := c.resource.client.doRequest(ctx, "RemoveBanRequest", http.MethodDelete, fmt.Sprintf("/moderation/bans?%s", query.Encode()), nil, opts...)
 	if err != nil {
 		return err
 	}
@@ -158,7 +158,7 @@ func (c *ClearChatRequest) Do(ctx context.Context, opts ...RequestOption) error
 	query.Set("moderator_id", c.moderatorId)
 	query.Set("message_id", c.messageId)
 
-	res, err := c.resource.client.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/moderation/chat?%s", query.Encode()), nil, opts...)
+	res, err := c.resource.client.doRequest(ctx, "ClearChatRequest", http.MethodDelete, fmt.Sprintf("/moderation/chat?%s", query.Encode()), nil, opts...)
 	if err != nil {
 		return err
 	}
@@ -1,5 +1,36 @@
 package api
 
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type Poll struct {
+	ID                         string       `json:"id"`
+	BroadcasterID              string       `json:"broadcaster_id"`
+	BroadcasterLogin           string       `json:"broadcaster_login"`
+	BroadcasterDisplayName     string       `json:"broadcaster_name"`
+	Title                      string       `json:"title"`
+	Choices                    []PollChoice `json:"choices"`
+	ChannelPointsVotingEnabled bool         `json:"channel_points_voting_enabled"`
+	ChannelPointsPerVote       int64        `json:"channel_points_per_vote"`
+	Status                     string       `json:"status"`
+	Duration                   int          `json:"duration"` // The length of the poll in seconds.
+	StartedAt                  time.Time    `json:"started_at"`
+	EndedAt                    *time.Time   `json:"ended_at"` // Nil while the poll is active.
+}
+
+type PollChoice struct {
+	ID                 string `json:"id"`
+	Title              string `json:"title"`
+	Votes              int    `json:"votes"`
+	ChannelPointsVotes int    `json:"channel_points_votes"`
+}
+
 type PollsResource struct {
 	client *Client
 }
@@ -7,3 +38,201 @@ type PollsResource struct {
 func NewPollsResource(client *Client) *PollsResource {
 	return &PollsResource{client}
 }
+
+type PollsListCall struct {
+	resource *PollsResource
+	opts     []RequestOption
+}
+
+type PollsListResponse struct {
+	Header http.Header
+	Data   []Poll
+	Cursor string
+}
+
+// List creates a request to list the polls of a broadcaster from the last 90 days.
+func (r *PollsResource) List(broadcasterId string) *PollsListCall {
+	c := &PollsListCall{resource: r}
+	c.opts = append(c.opts, SetQueryParameter("broadcaster_id", broadcasterId))
+	return c
+}
+
+// ID filters the results to the specified poll IDs.
+func (c *PollsListCall) ID(ids []string) *PollsListCall {
+	for _, id := range ids {
+		c.opts = append(c.opts, AddQueryParameter("id", id))
+	}
+	return c
+}
+
+// First sets the maximum number of polls to return per page.
+func (c *PollsListCall) First(n int) *PollsListCall {
+	c.opts = append(c.opts, SetQueryParameter("first", fmt.Sprint(n)))
+	return c
+}
+
+// After filters the results to those with a cursor value after the specified cursor.
+func (c *PollsListCall) After(cursor string) *PollsListCall {
+	c.opts = append(c.opts, SetQueryParameter("after", cursor))
+	return c
+}
+
+// Do executes the request.
+func (c *PollsListCall) Do(ctx context.Context, opts ...RequestOption) (*PollsListResponse, error) {
+	res, err := c.resource.client.doRequest(ctx, "PollsListCall", http.MethodGet, "/polls", nil, append(c.opts, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	data, err := decodeResponse[Poll](res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PollsListResponse{
+		Header: res.Header,
+		Data:   data.Data,
+		Cursor: data.Pagination.Cursor,
+	}, nil
+}
+
+// Pages creates an iterator over the pages of polls.
+func (c *PollsListCall) Pages(opts ...RequestOption) *Iterator[*PollsListResponse] {
+	return pages(c.Do, opts, func(res *PollsListResponse) string { return res.Cursor })
+}
+
+// All returns the polls from every page, up to the limit if it is greater than 0.
+func (c *PollsListCall) All(ctx context.Context, limit int, opts ...RequestOption) ([]Poll, error) {
+	return collect(ctx, c.Pages(opts...), limit, func(res *PollsListResponse) []Poll {
+		return res.Data
+	})
+}
+
+type PollsInsertCall struct {
+	resource *PollsResource
+	opts     []RequestOption
+	body     map[string]interface{}
+}
+
+type PollsInsertResponse struct {
+	Header http.Header
+	Data   []Poll
+}
+
+// Insert creates a request to start a poll in the channel of a broadcaster.
+//
+// A title, between 2 and 5 choices and a duration are required.
+func (r *PollsResource) Insert(broadcasterId string) *PollsInsertCall {
+	c := &PollsInsertCall{resource: r, body: make(map[string]interface{})}
+	c.body["broadcaster_id"] = broadcasterId
+	return c
+}
+
+func (c *PollsInsertCall) Title(title string) *PollsInsertCall {
+	c.body["title"] = title
+	return c
+}
+
+// Choices sets the titles of the choices viewers can vote for.
+func (c *PollsInsertCall) Choices(titles ...string) *PollsInsertCall {
+	choices := make([]map[string]string, len(titles))
+	for i, title := range titles {
+		choices[i] = map[string]string{"title": title}
+	}
+	c.body["choices"] = choices
+	return c
+}
+
+// Duration sets how long the poll runs for, between 15 seconds and 30 minutes.
+func (c *PollsInsertCall) Duration(d time.Duration) *PollsInsertCall {
+	c.body["duration"] = int(d.Seconds())
+	return c
+}
+
+func (c *PollsInsertCall) ChannelPointsVotingEnabled(enabled bool) *PollsInsertCall {
+	c.body["channel_points_voting_enabled"] = enabled
+	return c
+}
+
+// ChannelPointsPerVote sets the number of channel points each additional vote costs.
+func (c *PollsInsertCall) ChannelPointsPerVote(points int64) *PollsInsertCall {
+	c.body["channel_points_per_vote"] = points
+	return c
+}
+
+// Do executes the request.
+func (c *PollsInsertCall) Do(ctx context.Context, opts ...RequestOption) (*PollsInsertResponse, error) {
+	bs, err := json.Marshal(c.body)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.resource.client.doRequest(ctx, "PollsInsertCall", http.MethodPost, "/polls", bytes.NewReader(bs), append(c.opts, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	data, err := decodeResponse[Poll](res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PollsInsertResponse{
+		Header: res.Header,
+		Data:   data.Data,
+	}, nil
+}
+
+type PollsEndCall struct {
+	resource *PollsResource
+	opts     []RequestOption
+	body     map[string]interface{}
+}
+
+type PollsEndResponse struct {
+	Header http.Header
+	Data   []Poll
+}
+
+// End creates a request to end an active poll.
+//
+// The poll is terminated and its results stay visible in the channel unless Archive is called.
+func (r *PollsResource) End(broadcasterId, id string) *PollsEndCall {
+	c := &PollsEndCall{resource: r, body: make(map[string]interface{})}
+	c.body["broadcaster_id"] = broadcasterId
+	c.body["id"] = id
+	c.body["status"] = "TERMINATED"
+	return c
+}
+
+// Archive ends the poll and hides its results from the channel.
+func (c *PollsEndCall) Archive() *PollsEndCall {
+	c.body["status"] = "ARCHIVED"
+	return c
+}
+
+// Do executes the request.
+func (c *PollsEndCall) Do(ctx context.Context, opts ...RequestOption) (*PollsEndResponse, error) {
+	bs, err := json.Marshal(c.body)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.resource.client.doRequest(ctx, "PollsEndCall", http.MethodPatch, "/polls", bytes.NewReader(bs), append(c.opts, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	data, err := decodeResponse[Poll](res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PollsEndResponse{
+		Header: res.Header,
+		Data:   data.Data,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/adeithe/go-twitch/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPI_PollsInsert(t *testing.T) {
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, "/helix/polls", req.URL.Path)
+		var body map[string]interface{}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "1234", body["broadcaster_id"])
+		assert.Equal(t, "Heads or Tails?", body["title"])
+		assert.Equal(t, []interface{}{map[string]interface{}{"title": "Heads"}, map[string]interface{}{"title": "Tails"}}, body["choices"])
+		assert.Equal(t, float64(300), body["duration"])
+		assert.Equal(t, true, body["channel_points_voting_enabled"])
+		assert.Equal(t, float64(100), body["channel_points_per_vote"])
+		return newMockResponse(http.StatusOK, `{"data":[{"id":"1","broadcaster_id":"1234","title":"Heads or Tails?","choices":[{"id":"a","title":"Heads","votes":3,"channel_points_votes":1},{"id":"b","title":"Tails","votes":2,"channel_points_votes":0}],"channel_points_voting_enabled":true,"channel_points_per_vote":100,"status":"ACTIVE","duration":300,"started_at":"2021-03-19T06:08:33Z","ended_at":null}]}`), nil
+	})))
+
+	res, err := client.Polls.Insert("1234").
+		Title("Heads or Tails?").
+		Choices("Heads", "Tails").
+		Duration(time.Minute * 5).
+		ChannelPointsVotingEnabled(true).
+		ChannelPointsPerVote(100).
+		Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, res.Data, 1)
+	assert.Equal(t, "ACTIVE", res.Data[0].Status)
+	assert.Nil(t, res.Data[0].EndedAt)
+	assert.Len(t, res.Data[0].Choices, 2)
+	assert.Equal(t, 3, res.Data[0].Choices[0].Votes)
+}
+
+func TestAPI_PollsEnd(t *testing.T) {
+	var status string
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodPatch, req.Method)
+		var body map[string]string
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "1234", body["broadcaster_id"])
+		assert.Equal(t, "1", body["id"])
+		status = body["status"]
+		return newMockResponse(http.StatusOK, `{"data":[{"id":"1","status":"`+status+`","ended_at":"2021-03-19T06:11:26Z"}]}`), nil
+	})))
+
+	res, err := client.Polls.End("1234", "1").Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "TERMINATED", status)
+	assert.NotNil(t, res.Data[0].EndedAt)
+
+	if _, err := client.Polls.End("1234", "1").Archive().Do(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "ARCHIVED", status)
+}
@@ -1,5 +1,53 @@
 package api
 
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Errors returned by PredictionsEndCall before a request is sent
+var (
+	ErrMissingPredictionStatus = errors.New("twitchapi: one of lock, resolve or cancel must be set to end a prediction")
+	ErrMissingWinningOutcome   = errors.New("twitchapi: a winning outcome id is required to resolve a prediction")
+)
+
+type Prediction struct {
+	ID                     string              `json:"id"`
+	BroadcasterID          string              `json:"broadcaster_id"`
+	BroadcasterLogin       string              `json:"broadcaster_login"`
+	BroadcasterDisplayName string              `json:"broadcaster_name"`
+	Title                  string              `json:"title"`
+	WinningOutcomeID       string              `json:"winning_outcome_id"` // Only set once the prediction is resolved.
+	Outcomes               []PredictionOutcome `json:"outcomes"`
+	PredictionWindow       int                 `json:"prediction_window"` // How long viewers can make predictions in seconds.
+	Status                 string              `json:"status"`
+	CreatedAt              time.Time           `json:"created_at"`
+	EndedAt                *time.Time          `json:"ended_at"`
+	LockedAt               *time.Time          `json:"locked_at"`
+}
+
+type PredictionOutcome struct {
+	ID            string      `json:"id"`
+	Title         string      `json:"title"`
+	Users         int         `json:"users"`
+	ChannelPoints int64       `json:"channel_points"`
+	TopPredictors []Predictor `json:"top_predictors"`
+	Color         string      `json:"color"` // Possible values: "BLUE", "PINK"
+}
+
+type Predictor struct {
+	UserID            string `json:"user_id"`
+	UserLogin         string `json:"user_login"`
+	UserDisplayName   string `json:"user_name"`
+	ChannelPointsUsed int64  `json:"channel_points_used"`
+	ChannelPointsWon  int64  `json:"channel_points_won"`
+}
+
 type PredictionsResource struct {
 	client *Client
 }
@@ -7,3 +55,216 @@ type PredictionsResource struct {
 func NewPredictionsResource(client *Client) *PredictionsResource {
 	return &PredictionsResource{client}
 }
+
+type PredictionsListCall struct {
+	resource *PredictionsResource
+	opts     []RequestOption
+}
+
+type PredictionsListResponse struct {
+	Header http.Header
+	Data   []Prediction
+	Cursor string
+}
+
+// List creates a request to list the predictions of a broadcaster from the last 90 days.
+func (r *PredictionsResource) List(broadcasterId string) *PredictionsListCall {
+	c := &PredictionsListCall{resource: r}
+	c.opts = append(c.opts, SetQueryParameter("broadcaster_id", broadcasterId))
+	return c
+}
+
+// ID filters the results to the specified prediction IDs.
+func (c *PredictionsListCall) ID(ids []string) *PredictionsListCall {
+	for _, id := range ids {
+		c.opts = append(c.opts, AddQueryParameter("id", id))
+	}
+	return c
+}
+
+// First sets the maximum number of predictions to return per page.
+func (c *PredictionsListCall) First(n int) *PredictionsListCall {
+	c.opts = append(c.opts, SetQueryParameter("first", fmt.Sprint(n)))
+	return c
+}
+
+// After filters the results to those with a cursor value after the specified cursor.
+func (c *PredictionsListCall) After(cursor string) *PredictionsListCall {
+	c.opts = append(c.opts, SetQueryParameter("after", cursor))
+	return c
+}
+
+// Do executes the request.
+func (c *PredictionsListCall) Do(ctx context.Context, opts ...RequestOption) (*PredictionsListResponse, error) {
+	res, err := c.resource.client.doRequest(ctx, "PredictionsListCall", http.MethodGet, "/predictions", nil, append(c.opts, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	data, err := decodeResponse[Prediction](res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PredictionsListResponse{
+		Header: res.Header,
+		Data:   data.Data,
+		Cursor: data.Pagination.Cursor,
+	}, nil
+}
+
+// Pages creates an iterator over the pages of predictions.
+func (c *PredictionsListCall) Pages(opts ...RequestOption) *Iterator[*PredictionsListResponse] {
+	return pages(c.Do, opts, func(res *PredictionsListResponse) string { return res.Cursor })
+}
+
+// All returns the predictions from every page, up to the limit if it is greater than 0.
+func (c *PredictionsListCall) All(ctx context.Context, limit int, opts ...RequestOption) ([]Prediction, error) {
+	return collect(ctx, c.Pages(opts...), limit, func(res *PredictionsListResponse) []Prediction {
+		return res.Data
+	})
+}
+
+type PredictionsInsertCall struct {
+	resource *PredictionsResource
+	opts     []RequestOption
+	body     map[string]interface{}
+}
+
+type PredictionsInsertResponse struct {
+	Header http.Header
+	Data   []Prediction
+}
+
+// Insert creates a request to start a prediction in the channel of a broadcaster.
+//
+// A title, between 2 and 10 outcomes and a prediction window are required.
+func (r *PredictionsResource) Insert(broadcasterId string) *PredictionsInsertCall {
+	c := &PredictionsInsertCall{resource: r, body: make(map[string]interface{})}
+	c.body["broadcaster_id"] = broadcasterId
+	return c
+}
+
+func (c *PredictionsInsertCall) Title(title string) *PredictionsInsertCall {
+	c.body["title"] = title
+	return c
+}
+
+// Outcomes sets the titles of the outcomes viewers can predict.
+//
+// The first outcome is shown in blue and every other outcome in pink.
+func (c *PredictionsInsertCall) Outcomes(titles ...string) *PredictionsInsertCall {
+	outcomes := make([]map[string]string, len(titles))
+	for i, title := range titles {
+		outcomes[i] = map[string]string{"title": title}
+	}
+	c.body["outcomes"] = outcomes
+	return c
+}
+
+// PredictionWindow sets how long viewers can make predictions for, between 30 seconds and 30 minutes.
+func (c *PredictionsInsertCall) PredictionWindow(d time.Duration) *PredictionsInsertCall {
+	c.body["prediction_window"] = int(d.Seconds())
+	return c
+}
+
+// Do executes the request.
+func (c *PredictionsInsertCall) Do(ctx context.Context, opts ...RequestOption) (*PredictionsInsertResponse, error) {
+	bs, err := json.Marshal(c.body)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.resource.client.doRequest(ctx, "PredictionsInsertCall", http.MethodPost, "/predictions", bytes.NewReader(bs), append(c.opts, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	data, err := decodeResponse[Prediction](res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PredictionsInsertResponse{
+		Header: res.Header,
+		Data:   data.Data,
+	}, nil
+}
+
+type PredictionsEndCall struct {
+	resource *PredictionsResource
+	opts     []RequestOption
+	body     map[string]interface{}
+}
+
+type PredictionsEndResponse struct {
+	Header http.Header
+	Data   []Prediction
+}
+
+// End creates a request to lock, resolve or cancel a prediction.
+//
+// One of Lock, Resolve or Cancel must be called before the request is executed. If more than one is called, the
+// last call wins.
+func (r *PredictionsResource) End(broadcasterId, id string) *PredictionsEndCall {
+	c := &PredictionsEndCall{resource: r, body: make(map[string]interface{})}
+	c.body["broadcaster_id"] = broadcasterId
+	c.body["id"] = id
+	return c
+}
+
+// Lock stops viewers from making predictions.
+func (c *PredictionsEndCall) Lock() *PredictionsEndCall {
+	c.body["status"] = "LOCKED"
+	delete(c.body, "winning_outcome_id")
+	return c
+}
+
+// Resolve ends the prediction and pays out the channel points to the viewers who predicted the winning outcome.
+func (c *PredictionsEndCall) Resolve(winningOutcomeId string) *PredictionsEndCall {
+	c.body["status"] = "RESOLVED"
+	c.body["winning_outcome_id"] = winningOutcomeId
+	return c
+}
+
+// Cancel ends the prediction and refunds the channel points of every viewer.
+func (c *PredictionsEndCall) Cancel() *PredictionsEndCall {
+	c.body["status"] = "CANCELED"
+	delete(c.body, "winning_outcome_id")
+	return c
+}
+
+// Do executes the request.
+func (c *PredictionsEndCall) Do(ctx context.Context, opts ...RequestOption) (*PredictionsEndResponse, error) {
+	switch c.body["status"] {
+	case nil:
+		return nil, ErrMissingPredictionStatus
+	case "RESOLVED":
+		if id, _ := c.body["winning_outcome_id"].(string); len(id) < 1 {
+			return nil, ErrMissingWinningOutcome
+		}
+	}
+
+	bs, err := json.Marshal(c.body)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.resource.client.doRequest(ctx, "PredictionsEndCall", http.MethodPatch, "/predictions", bytes.NewReader(bs), append(c.opts, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	data, err := decodeResponse[Prediction](res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PredictionsEndResponse{
+		Header: res.Header,
+		Data:   data.Data,
+	}, nil
+}
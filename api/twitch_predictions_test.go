@@ -0,0 +1,102 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/adeithe/go-twitch/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPI_PredictionsInsert(t *testing.T) {
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, "/helix/predictions", req.URL.Path)
+		var body map[string]interface{}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "1234", body["broadcaster_id"])
+		assert.Equal(t, []interface{}{map[string]interface{}{"title": "Yes"}, map[string]interface{}{"title": "No"}}, body["outcomes"])
+		assert.Equal(t, float64(120), body["prediction_window"])
+		return newMockResponse(http.StatusOK, `{"data":[{"id":"1","broadcaster_id":"1234","title":"Will we win?","winning_outcome_id":null,"outcomes":[{"id":"a","title":"Yes","users":1,"channel_points":500,"top_predictors":[{"user_id":"5678","user_login":"viewer","user_name":"Viewer","channel_points_used":500,"channel_points_won":0}],"color":"BLUE"},{"id":"b","title":"No","users":0,"channel_points":0,"top_predictors":null,"color":"PINK"}],"prediction_window":120,"status":"ACTIVE","created_at":"2021-04-28T17:11:22Z","ended_at":null,"locked_at":null}]}`), nil
+	})))
+
+	res, err := client.Predictions.Insert("1234").
+		Title("Will we win?").
+		Outcomes("Yes", "No").
+		PredictionWindow(time.Minute * 2).
+		Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	prediction := res.Data[0]
+	assert.Empty(t, prediction.WinningOutcomeID)
+	assert.Equal(t, "BLUE", prediction.Outcomes[0].Color)
+	assert.Equal(t, int64(500), prediction.Outcomes[0].TopPredictors[0].ChannelPointsUsed)
+	assert.Nil(t, prediction.LockedAt)
+}
+
+func TestAPI_PredictionsEnd(t *testing.T) {
+	var body map[string]string
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodPatch, req.Method)
+		body = nil
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		return newMockResponse(http.StatusOK, `{"data":[{"id":"1","status":"`+body["status"]+`"}]}`), nil
+	})))
+	ctx := context.Background()
+
+	_, err := client.Predictions.End("1234", "1").Do(ctx)
+	assert.Equal(t, api.ErrMissingPredictionStatus, err)
+	_, err = client.Predictions.End("1234", "1").Resolve("").Do(ctx)
+	assert.Equal(t, api.ErrMissingWinningOutcome, err)
+	assert.Nil(t, body)
+
+	_, err = client.Predictions.End("1234", "1").Lock().Do(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"broadcaster_id": "1234", "id": "1", "status": "LOCKED"}, body)
+
+	_, err = client.Predictions.End("1234", "1").Resolve("a").Do(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "RESOLVED", body["status"])
+	assert.Equal(t, "a", body["winning_outcome_id"])
+
+}
+
+func TestAPI_PredictionsEndSwitchStatus(t *testing.T) {
+	var body map[string]string
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		body = nil
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		return newMockResponse(http.StatusOK, `{"data":[{"id":"1","status":"`+body["status"]+`"}]}`), nil
+	})))
+
+	tests := []struct {
+		Name     string
+		Call     *api.PredictionsEndCall
+		Expected map[string]string
+	}{
+		{"LockThenResolve", client.Predictions.End("1234", "1").Lock().Resolve("a"), map[string]string{"status": "RESOLVED", "winning_outcome_id": "a"}},
+		{"ResolveThenLock", client.Predictions.End("1234", "1").Resolve("a").Lock(), map[string]string{"status": "LOCKED"}},
+		{"ResolveThenCancel", client.Predictions.End("1234", "1").Resolve("a").Cancel(), map[string]string{"status": "CANCELED"}},
+		{"CancelThenResolve", client.Predictions.End("1234", "1").Cancel().Resolve("b"), map[string]string{"status": "RESOLVED", "winning_outcome_id": "b"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			_, err := test.Call.Do(context.Background())
+			assert.NoError(t, err)
+			test.Expected["broadcaster_id"] = "1234"
+			test.Expected["id"] = "1"
+			assert.Equal(t, test.Expected, body)
+		})
+	}
+}
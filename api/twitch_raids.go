@@ -1,5 +1,26 @@
 package api
 
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// Errors that the errors returned by raid requests match with errors.Is
+//
+// The errors also match the sentinel error for their status code and can be converted to an APIError with errors.As.
+var (
+	ErrRaidInProgress = errors.New("twitchapi: broadcaster is already raiding another channel")
+	ErrTooManyRaids   = errors.New("twitchapi: too many raids were started in the last 10 minutes")
+	ErrNoPendingRaid  = errors.New("twitchapi: broadcaster does not have a pending raid")
+)
+
+type Raid struct {
+	CreatedAt time.Time `json:"created_at"`
+	IsMature  bool      `json:"is_mature"`
+}
+
 type RaidsResource struct {
 	client *Client
 }
@@ -7,3 +28,103 @@ type RaidsResource struct {
 func NewRaidsResource(client *Client) *RaidsResource {
 	return &RaidsResource{client}
 }
+
+type RaidsStartCall struct {
+	resource *RaidsResource
+	opts     []RequestOption
+}
+
+type RaidsStartResponse struct {
+	Header http.Header
+	Data   []Raid
+}
+
+// Start creates a request to raid another broadcaster.
+//
+// The raid starts once the broadcaster confirms it or after 90 seconds. Only 10 raids can be requested every 10 minutes.
+func (r *RaidsResource) Start(fromBroadcasterId, toBroadcasterId string) *RaidsStartCall {
+	c := &RaidsStartCall{resource: r}
+	c.opts = append(c.opts, SetQueryParameter("from_broadcaster_id", fromBroadcasterId))
+	c.opts = append(c.opts, SetQueryParameter("to_broadcaster_id", toBroadcasterId))
+	return c
+}
+
+// Do executes the request.
+//
+// If the broadcaster is already raiding, the error matches ErrRaidInProgress. If too many raids have been requested,
+// the error matches ErrTooManyRaids.
+func (c *RaidsStartCall) Do(ctx context.Context, opts ...RequestOption) (*RaidsStartResponse, error) {
+	res, err := c.resource.client.doRequest(ctx, "RaidsStartCall", http.MethodPost, "/raids", nil, append(c.opts, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	data, err := decodeResponse[Raid](res)
+	if err != nil {
+		return nil, newRaidError(err, map[int]error{
+			http.StatusConflict:        ErrRaidInProgress,
+			http.StatusTooManyRequests: ErrTooManyRaids,
+		})
+	}
+
+	return &RaidsStartResponse{
+		Header: res.Header,
+		Data:   data.Data,
+	}, nil
+}
+
+type RaidsCancelCall struct {
+	resource *RaidsResource
+	opts     []RequestOption
+}
+
+// Cancel creates a request to cancel a pending raid.
+func (r *RaidsResource) Cancel(broadcasterId string) *RaidsCancelCall {
+	c := &RaidsCancelCall{resource: r}
+	c.opts = append(c.opts, SetQueryParameter("broadcaster_id", broadcasterId))
+	return c
+}
+
+// Do executes the request.
+//
+// If the broadcaster does not have a pending raid, the error matches ErrNoPendingRaid.
+func (c *RaidsCancelCall) Do(ctx context.Context, opts ...RequestOption) error {
+	res, err := c.resource.client.doRequest(ctx, "RaidsCancelCall", http.MethodDelete, "/raids", nil, append(c.opts, opts...)...)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	_, err = decodeResponse[Raid](res)
+	return newRaidError(err, map[int]error{
+		http.StatusNotFound:        ErrNoPendingRaid,
+		http.StatusTooManyRequests: ErrTooManyRaids,
+	})
+}
+
+// raidError is an APIError that also matches the raid error for its status code.
+type raidError struct {
+	*APIError
+	reason error
+}
+
+// newRaidError wraps an APIError with the raid error for its status code, if there is one.
+func newRaidError(err error, reasons map[int]error) error {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+	if reason, ok := reasons[apiErr.Status]; ok {
+		return &raidError{apiErr, reason}
+	}
+	return err
+}
+
+func (err *raidError) Is(target error) bool {
+	return target == err.reason || err.APIError.Is(target)
+}
+
+func (err *raidError) Unwrap() error {
+	return err.APIError
+}
@@ -0,0 +1,61 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/adeithe/go-twitch/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPI_RaidsStart(t *testing.T) {
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, "/helix/raids", req.URL.Path)
+		assert.Equal(t, "1234", req.URL.Query().Get("from_broadcaster_id"))
+		assert.Equal(t, "5678", req.URL.Query().Get("to_broadcaster_id"))
+		return newMockResponse(http.StatusOK, `{"data":[{"created_at":"2022-02-18T07:20:50.52Z","is_mature":true}]}`), nil
+	})))
+
+	res, err := client.Raids.Start("1234", "5678").Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, res.Data[0].IsMature)
+	assert.Equal(t, time.Date(2022, 2, 18, 7, 20, 50, 520000000, time.UTC), res.Data[0].CreatedAt)
+}
+
+func TestAPI_RaidsErrors(t *testing.T) {
+	var sent int32
+	var status int
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&sent, 1)
+		return newRateLimitedResponse(status, `{"error":"`+http.StatusText(status)+`","status":0,"message":""}`, 799, time.Now().Add(time.Minute)), nil
+	})))
+	ctx := context.Background()
+
+	status = http.StatusConflict
+	_, err := client.Raids.Start("1234", "5678").Do(ctx, api.WithBearerToken("token"))
+	assert.True(t, errors.Is(err, api.ErrRaidInProgress))
+	assert.True(t, errors.Is(err, api.ErrConflict))
+
+	status = http.StatusTooManyRequests
+	_, err = client.Raids.Start("1234", "5678").Do(ctx, api.WithBearerToken("token"))
+	assert.True(t, errors.Is(err, api.ErrTooManyRaids))
+	assert.True(t, errors.Is(err, api.ErrRateLimited))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&sent), "raid limits should not be retried")
+
+	var apiErr *api.APIError
+	if assert.True(t, errors.As(err, &apiErr)) {
+		assert.Equal(t, "/helix/raids", apiErr.Path)
+	}
+
+	status = http.StatusNotFound
+	err = client.Raids.Cancel("1234").Do(ctx, api.WithBearerToken("token"))
+	assert.True(t, errors.Is(err, api.ErrNoPendingRaid))
+	assert.False(t, errors.Is(err, api.ErrRaidInProgress))
+}
@@ -1,9 +1,570 @@
 package api
 
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type Schedule struct {
+	Segments               []ScheduleSegment `json:"segments"`
+	BroadcasterID          string            `json:"broadcaster_id"`
+	BroadcasterLogin       string            `json:"broadcaster_login"`
+	BroadcasterDisplayName string            `json:"broadcaster_name"`
+	Vacation               *ScheduleVacation `json:"vacation"` // Nil unless the broadcaster has scheduled a vacation.
+}
+
+type ScheduleSegment struct {
+	ID            string            `json:"id"`
+	StartTime     time.Time         `json:"start_time"`
+	EndTime       time.Time         `json:"end_time"`
+	Title         string            `json:"title"`
+	CanceledUntil *time.Time        `json:"canceled_until"`
+	Category      *ScheduleCategory `json:"category"`
+	IsRecurring   bool              `json:"is_recurring"`
+}
+
+type ScheduleCategory struct {
+	ID   string `json:"id"` // Not included in segments parsed from an iCalendar.
+	Name string `json:"name"`
+}
+
+type ScheduleVacation struct {
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+}
+
 type ScheduleResource struct {
 	client *Client
+
+	Segments *ScheduleSegmentsResource
 }
 
 func NewScheduleResource(client *Client) *ScheduleResource {
-	return &ScheduleResource{client}
+	r := &ScheduleResource{client: client}
+	r.Segments = NewScheduleSegmentsResource(client)
+	return r
+}
+
+type ScheduleListCall struct {
+	resource *ScheduleResource
+	opts     []RequestOption
+}
+
+type ScheduleListResponse struct {
+	Header   http.Header
+	Schedule Schedule
+	Cursor   string
+}
+
+// List creates a request to get the stream schedule of a broadcaster.
+func (r *ScheduleResource) List(broadcasterId string) *ScheduleListCall {
+	c := &ScheduleListCall{resource: r}
+	c.opts = append(c.opts, SetQueryParameter("broadcaster_id", broadcasterId))
+	return c
+}
+
+// ID filters the results to the specified segment IDs.
+func (c *ScheduleListCall) ID(ids []string) *ScheduleListCall {
+	for _, id := range ids {
+		c.opts = append(c.opts, AddQueryParameter("id", id))
+	}
+	return c
+}
+
+// StartTime filters the results to segments that start on or after the specified time.
+func (c *ScheduleListCall) StartTime(t time.Time) *ScheduleListCall {
+	c.opts = append(c.opts, SetQueryParameter("start_time", t.Format(time.RFC3339)))
+	return c
+}
+
+// First sets the maximum number of segments to return per page.
+func (c *ScheduleListCall) First(n int) *ScheduleListCall {
+	c.opts = append(c.opts, SetQueryParameter("first", fmt.Sprint(n)))
+	return c
+}
+
+// After filters the results to those with a cursor value after the specified cursor.
+func (c *ScheduleListCall) After(cursor string) *ScheduleListCall {
+	c.opts = append(c.opts, SetQueryParameter("after", cursor))
+	return c
+}
+
+// Do executes the request.
+func (c *ScheduleListCall) Do(ctx context.Context, opts ...RequestOption) (*ScheduleListResponse, error) {
+	res, err := c.resource.client.doRequest(ctx, "ScheduleListCall", http.MethodGet, "/schedule", nil, append(c.opts, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	data, err := decodeResponse[Schedule](res)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ScheduleListResponse{
+		Header: res.Header,
+		Cursor: data.Pagination.Cursor,
+	}
+	if len(data.Data) > 0 {
+		r.Schedule = data.Data[0]
+	}
+	return r, nil
+}
+
+// Pages creates an iterator over the pages of segments.
+func (c *ScheduleListCall) Pages(opts ...RequestOption) *Iterator[*ScheduleListResponse] {
+	return pages(c.Do, opts, func(res *ScheduleListResponse) string { return res.Cursor })
+}
+
+// All returns the segments from every page, up to the limit if it is greater than 0.
+func (c *ScheduleListCall) All(ctx context.Context, limit int, opts ...RequestOption) ([]ScheduleSegment, error) {
+	return collect(ctx, c.Pages(opts...), limit, func(res *ScheduleListResponse) []ScheduleSegment {
+		return res.Schedule.Segments
+	})
+}
+
+type ScheduleUpdateSettingsCall struct {
+	resource *ScheduleResource
+	opts     []RequestOption
+}
+
+// UpdateSettings creates a request to update the vacation settings of the stream schedule of a broadcaster.
+func (r *ScheduleResource) UpdateSettings(broadcasterId string) *ScheduleUpdateSettingsCall {
+	c := &ScheduleUpdateSettingsCall{resource: r}
+	c.opts = append(c.opts, SetQueryParameter("broadcaster_id", broadcasterId))
+	return c
+}
+
+// IsVacationEnabled sets whether the broadcaster is on vacation.
+//
+// When enabling a vacation, the start time, end time and timezone are required.
+func (c *ScheduleUpdateSettingsCall) IsVacationEnabled(enabled bool) *ScheduleUpdateSettingsCall {
+	c.opts = append(c.opts, SetQueryParameter("is_vacation_enabled", fmt.Sprint(enabled)))
+	return c
+}
+
+func (c *ScheduleUpdateSettingsCall) VacationStartTime(t time.Time) *ScheduleUpdateSettingsCall {
+	c.opts = append(c.opts, SetQueryParameter("vacation_start_time", t.Format(time.RFC3339)))
+	return c
+}
+
+func (c *ScheduleUpdateSettingsCall) VacationEndTime(t time.Time) *ScheduleUpdateSettingsCall {
+	c.opts = append(c.opts, SetQueryParameter("vacation_end_time", t.Format(time.RFC3339)))
+	return c
+}
+
+// Timezone sets the IANA time zone of the broadcaster, such as "America/New_York".
+func (c *ScheduleUpdateSettingsCall) Timezone(tz string) *ScheduleUpdateSettingsCall {
+	c.opts = append(c.opts, SetQueryParameter("timezone", tz))
+	return c
+}
+
+// Do executes the request.
+func (c *ScheduleUpdateSettingsCall) Do(ctx context.Context, opts ...RequestOption) error {
+	res, err := c.resource.client.doRequest(ctx, "ScheduleUpdateSettingsCall", http.MethodPatch, "/schedule/settings", nil, append(c.opts, opts...)...)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	_, err = decodeResponse[Schedule](res)
+	return err
+}
+
+type ScheduleICalendarCall struct {
+	resource *ScheduleResource
+	opts     []RequestOption
+}
+
+type ScheduleICalendarResponse struct {
+	Header   http.Header
+	Name     string // The name of the calendar, which is the display name of the broadcaster.
+	Segments []ScheduleSegment
+	Raw      []byte // The iCalendar as sent by Twitch.
+}
+
+// ICalendar creates a request to get the stream schedule of a broadcaster as an iCalendar.
+//
+// The segments parsed from the calendar only include the ID, start and end times, title, category name and
+// whether they are recurring.
+func (r *ScheduleResource) ICalendar(broadcasterId string) *ScheduleICalendarCall {
+	c := &ScheduleICalendarCall{resource: r}
+	c.opts = append(c.opts, SetQueryParameter("broadcaster_id", broadcasterId))
+	return c
+}
+
+// Do executes the request.
+func (c *ScheduleICalendarCall) Do(ctx context.Context, opts ...RequestOption) (*ScheduleICalendarResponse, error) {
+	res, err := c.resource.client.doRequest(ctx, "ScheduleICalendarCall", http.MethodGet, "/schedule/icalendar", nil, append(c.opts, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest {
+		_, err := decodeResponse[Schedule](res)
+		return nil, err
+	}
+
+	raw, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	name, segments, err := parseICalendar(bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ScheduleICalendarResponse{
+		Header:   res.Header,
+		Name:     name,
+		Segments: segments,
+		Raw:      raw,
+	}, nil
+}
+
+type ScheduleSegmentsResource struct {
+	client *Client
+}
+
+func NewScheduleSegmentsResource(client *Client) *ScheduleSegmentsResource {
+	return &ScheduleSegmentsResource{client}
+}
+
+type ScheduleSegmentsInsertCall struct {
+	resource *ScheduleSegmentsResource
+	opts     []RequestOption
+	body     map[string]interface{}
+}
+
+type ScheduleSegmentsInsertResponse struct {
+	Header   http.Header
+	Schedule Schedule // Only includes the segment that was created.
+}
+
+// Insert creates a request to add a segment to the stream schedule of a broadcaster.
+//
+// A start time, timezone and duration are required.
+func (r *ScheduleSegmentsResource) Insert(broadcasterId string) *ScheduleSegmentsInsertCall {
+	c := &ScheduleSegmentsInsertCall{resource: r, body: make(map[string]interface{})}
+	c.opts = append(c.opts, SetQueryParameter("broadcaster_id", broadcasterId))
+	return c
+}
+
+func (c *ScheduleSegmentsInsertCall) StartTime(t time.Time) *ScheduleSegmentsInsertCall {
+	c.body["start_time"] = t.Format(time.RFC3339)
+	return c
+}
+
+// Timezone sets the IANA time zone used to schedule recurring segments, such as "America/New_York".
+func (c *ScheduleSegmentsInsertCall) Timezone(tz string) *ScheduleSegmentsInsertCall {
+	c.body["timezone"] = tz
+	return c
+}
+
+// Duration sets the length of the segment, between 30 minutes and 23 hours.
+func (c *ScheduleSegmentsInsertCall) Duration(d time.Duration) *ScheduleSegmentsInsertCall {
+	c.body["duration"] = fmt.Sprint(int(d.Minutes()))
+	return c
+}
+
+// IsRecurring sets whether the segment repeats every week. Default: true
+func (c *ScheduleSegmentsInsertCall) IsRecurring(recurring bool) *ScheduleSegmentsInsertCall {
+	c.body["is_recurring"] = recurring
+	return c
+}
+
+func (c *ScheduleSegmentsInsertCall) CategoryID(id string) *ScheduleSegmentsInsertCall {
+	c.body["category_id"] = id
+	return c
+}
+
+func (c *ScheduleSegmentsInsertCall) Title(title string) *ScheduleSegmentsInsertCall {
+	c.body["title"] = title
+	return c
+}
+
+// Do executes the request.
+func (c *ScheduleSegmentsInsertCall) Do(ctx context.Context, opts ...RequestOption) (*ScheduleSegmentsInsertResponse, error) {
+	bs, err := json.Marshal(c.body)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.resource.client.doRequest(ctx, "ScheduleSegmentsInsertCall", http.MethodPost, "/schedule/segment", bytes.NewReader(bs), append(c.opts, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	data, err := decodeResponse[Schedule](res)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ScheduleSegmentsInsertResponse{Header: res.Header}
+	if len(data.Data) > 0 {
+		r.Schedule = data.Data[0]
+	}
+	return r, nil
+}
+
+type ScheduleSegmentsUpdateCall struct {
+	resource *ScheduleSegmentsResource
+	opts     []RequestOption
+	body     map[string]interface{}
+}
+
+type ScheduleSegmentsUpdateResponse struct {
+	Header   http.Header
+	Schedule Schedule // Only includes the segment that was updated.
+}
+
+// Update creates a request to update a segment of the stream schedule of a broadcaster.
+//
+// Updating a recurring segment changes every occurrence of it.
+func (r *ScheduleSegmentsResource) Update(broadcasterId, id string) *ScheduleSegmentsUpdateCall {
+	c := &ScheduleSegmentsUpdateCall{resource: r, body: make(map[string]interface{})}
+	c.opts = append(c.opts, SetQueryParameter("broadcaster_id", broadcasterId))
+	c.opts = append(c.opts, SetQueryParameter("id", id))
+	return c
+}
+
+func (c *ScheduleSegmentsUpdateCall) StartTime(t time.Time) *ScheduleSegmentsUpdateCall {
+	c.body["start_time"] = t.Format(time.RFC3339)
+	return c
+}
+
+// Timezone sets the IANA time zone used to schedule recurring segments, such as "America/New_York".
+func (c *ScheduleSegmentsUpdateCall) Timezone(tz string) *ScheduleSegmentsUpdateCall {
+	c.body["timezone"] = tz
+	return c
+}
+
+// Duration sets the length of the segment, between 30 minutes and 23 hours.
+func (c *ScheduleSegmentsUpdateCall) Duration(d time.Duration) *ScheduleSegmentsUpdateCall {
+	c.body["duration"] = fmt.Sprint(int(d.Minutes()))
+	return c
+}
+
+func (c *ScheduleSegmentsUpdateCall) CategoryID(id string) *ScheduleSegmentsUpdateCall {
+	c.body["category_id"] = id
+	return c
+}
+
+func (c *ScheduleSegmentsUpdateCall) Title(title string) *ScheduleSegmentsUpdateCall {
+	c.body["title"] = title
+	return c
+}
+
+// IsCanceled sets whether the next occurrence of the segment is canceled.
+func (c *ScheduleSegmentsUpdateCall) IsCanceled(canceled bool) *ScheduleSegmentsUpdateCall {
+	c.body["is_canceled"] = canceled
+	return c
+}
+
+// Do executes the request.
+func (c *ScheduleSegmentsUpdateCall) Do(ctx context.Context, opts ...RequestOption) (*ScheduleSegmentsUpdateResponse, error) {
+	bs, err := json.Marshal(c.body)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.resource.client.doRequest(ctx, "ScheduleSegmentsUpdateCall", http.MethodPatch, "/schedule/segment", bytes.NewReader(bs), append(c.opts, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	data, err := decodeResponse[Schedule](res)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ScheduleSegmentsUpdateResponse{Header: res.Header}
+	if len(data.Data) > 0 {
+		r.Schedule = data.Data[0]
+	}
+	return r, nil
+}
+
+type ScheduleSegmentsDeleteCall struct {
+	resource *ScheduleSegmentsResource
+	opts     []RequestOption
+}
+
+// Delete creates a request to remove a segment from the stream schedule of a broadcaster.
+//
+// Deleting a recurring segment removes every occurrence of it.
+func (r *ScheduleSegmentsResource) Delete(broadcasterId, id string) *ScheduleSegmentsDeleteCall {
+	c := &ScheduleSegmentsDeleteCall{resource: r}
+	c.opts = append(c.opts, SetQueryParameter("broadcaster_id", broadcasterId))
+	c.opts = append(c.opts, SetQueryParameter("id", id))
+	return c
+}
+
+// Do executes the request.
+func (c *ScheduleSegmentsDeleteCall) Do(ctx context.Context, opts ...RequestOption) error {
+	res, err := c.resource.client.doRequest(ctx, "ScheduleSegmentsDeleteCall", http.MethodDelete, "/schedule/segment", nil, append(c.opts, opts...)...)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	_, err = decodeResponse[Schedule](res)
+	return err
+}
+
+// parseICalendar reads the name of the calendar and a segment for every event in an iCalendar.
+func parseICalendar(r io.Reader) (string, []ScheduleSegment, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		// Long lines are folded onto the next line starting with whitespace.
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return "", nil, err
+	}
+
+	var name string
+	var segments []ScheduleSegment
+	var segment *ScheduleSegment
+	// The offsets of the VTIMEZONE components are used for time zones missing from the tz database of the host.
+	var timezone string
+	var standard bool
+	offsets := make(map[string]*time.Location)
+	for _, line := range lines {
+		prop, params, value, ok := parseICalendarLine(line)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case prop == "BEGIN" && value == "VTIMEZONE":
+			timezone = ""
+		case prop == "BEGIN" && (value == "STANDARD" || value == "DAYLIGHT"):
+			standard = value == "STANDARD"
+		case prop == "TZID" && segment == nil:
+			timezone = value
+		case prop == "TZOFFSETTO" && segment == nil:
+			offset, err := parseICalendarOffset(value)
+			if err != nil {
+				return "", nil, fmt.Errorf("twitchapi: invalid icalendar %s %q: %w", prop, value, err)
+			}
+			if standard || offsets[timezone] == nil {
+				offsets[timezone] = time.FixedZone(strings.TrimPrefix(timezone, "/"), offset)
+			}
+		case prop == "BEGIN" && value == "VEVENT":
+			segment = &ScheduleSegment{}
+		case prop == "END" && value == "VEVENT" && segment != nil:
+			segments = append(segments, *segment)
+			segment = nil
+		case segment == nil:
+			if prop == "NAME" || (prop == "X-WR-CALNAME" && name == "") {
+				name = unescapeICalendarText(value)
+			}
+		case prop == "UID":
+			segment.ID = value
+		case prop == "DTSTART", prop == "DTEND":
+			t, err := parseICalendarTime(value, params["TZID"], offsets)
+			if err != nil {
+				return "", nil, fmt.Errorf("twitchapi: invalid icalendar %s %q: %w", prop, value, err)
+			}
+			if prop == "DTSTART" {
+				segment.StartTime = t
+			} else {
+				segment.EndTime = t
+			}
+		case prop == "SUMMARY":
+			segment.Title = unescapeICalendarText(value)
+		case prop == "CATEGORIES":
+			segment.Category = &ScheduleCategory{Name: unescapeICalendarText(value)}
+		case prop == "RRULE":
+			segment.IsRecurring = true
+		}
+	}
+	return name, segments, nil
+}
+
+// parseICalendarLine splits a content line such as "DTSTART;TZID=/America/New_York:20210701T140000" into
+// its property name, parameters and value.
+func parseICalendarLine(line string) (string, map[string]string, string, bool) {
+	var quoted bool
+	for i, r := range line {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == ':' && !quoted:
+			parts := strings.Split(line[:i], ";")
+			params := make(map[string]string, len(parts)-1)
+			for _, param := range parts[1:] {
+				if k, v, ok := strings.Cut(param, "="); ok {
+					params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+				}
+			}
+			return strings.ToUpper(parts[0]), params, line[i+1:], true
+		}
+	}
+	return "", nil, "", false
+}
+
+// parseICalendarTime parses a date or date-time value in the provided time zone.
+//
+// Twitch prefixes the time zone with a slash, such as "/America/New_York". When the time zone cannot be loaded,
+// such as on hosts without a tz database, the standard offset from the VTIMEZONE of the calendar is used
+// instead, or UTC if the calendar does not describe the time zone.
+func parseICalendarTime(value, tzid string, offsets map[string]*time.Location) (time.Time, error) {
+	if strings.HasSuffix(value, "Z") {
+		return time.Parse("20060102T150405Z", value)
+	}
+	loc := time.UTC
+	if tzid != "" {
+		var err error
+		if loc, err = time.LoadLocation(strings.TrimPrefix(tzid, "/")); err != nil {
+			if loc = offsets[tzid]; loc == nil {
+				loc = time.UTC
+			}
+		}
+	}
+	if len(value) == len("20060102") {
+		return time.ParseInLocation("20060102", value, loc)
+	}
+	return time.ParseInLocation("20060102T150405", value, loc)
+}
+
+// parseICalendarOffset parses a UTC offset such as "-0400" or "+053000" into seconds east of UTC.
+func parseICalendarOffset(value string) (int, error) {
+	if (len(value) != len("+0000") && len(value) != len("+000000")) || (value[0] != '+' && value[0] != '-') {
+		return 0, errors.New("malformed offset")
+	}
+	t, err := time.Parse("150405", (value[1:] + "00")[:6])
+	if err != nil {
+		return 0, err
+	}
+	offset := t.Hour()*3600 + t.Minute()*60 + t.Second()
+	if value[0] == '-' {
+		offset = -offset
+	}
+	return offset, nil
+}
+
+var icalendarTextReplacer = strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`)
+
+func unescapeICalendarText(value string) string {
+	return icalendarTextReplacer.Replace(value)
 }
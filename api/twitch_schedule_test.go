@@ -0,0 +1,179 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/adeithe/go-twitch/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPI_ScheduleList(t *testing.T) {
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/helix/schedule", req.URL.Path)
+		assert.Equal(t, "141981764", req.URL.Query().Get("broadcaster_id"))
+		if req.URL.Query().Get("after") == "" {
+			return newMockResponse(http.StatusOK, `{"data":{"segments":[{"id":"1","start_time":"2021-07-01T18:00:00Z","end_time":"2021-07-01T19:00:00Z","title":"TwitchDev Monthly Update","canceled_until":null,"category":{"id":"509670","name":"Science & Technology"},"is_recurring":false}],"broadcaster_id":"141981764","broadcaster_name":"TwitchDev","broadcaster_login":"twitchdev","vacation":{"start_time":"2021-08-01T00:00:00Z","end_time":"2021-08-14T00:00:00Z"}},"pagination":{"cursor":"abc"}}`), nil
+		}
+		return newMockResponse(http.StatusOK, `{"data":{"segments":[{"id":"2","start_time":"2021-08-15T18:00:00Z","end_time":"2021-08-15T19:00:00Z","title":"","canceled_until":null,"category":null,"is_recurring":true}],"broadcaster_id":"141981764","vacation":null},"pagination":{}}`), nil
+	})))
+
+	res, err := client.Schedule.List("141981764").Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "twitchdev", res.Schedule.BroadcasterLogin)
+	assert.Equal(t, "abc", res.Cursor)
+	if assert.NotNil(t, res.Schedule.Vacation) {
+		assert.Equal(t, time.Date(2021, 8, 14, 0, 0, 0, 0, time.UTC), res.Schedule.Vacation.EndTime)
+	}
+	assert.Equal(t, "Science & Technology", res.Schedule.Segments[0].Category.Name)
+
+	segments, err := client.Schedule.List("141981764").All(context.Background(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, segments, 2)
+	assert.True(t, segments[1].IsRecurring)
+	assert.Nil(t, segments[1].Category)
+}
+
+func TestAPI_ScheduleSegmentsInsert(t *testing.T) {
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, "/helix/schedule/segment", req.URL.Path)
+		var body map[string]interface{}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "2021-07-01T18:00:00Z", body["start_time"])
+		assert.Equal(t, "America/New_York", body["timezone"])
+		assert.Equal(t, "60", body["duration"])
+		assert.Equal(t, true, body["is_recurring"])
+		return newMockResponse(http.StatusOK, `{"data":{"segments":[{"id":"1","start_time":"2021-07-01T18:00:00Z","end_time":"2021-07-01T19:00:00Z","is_recurring":true}],"broadcaster_id":"141981764"}}`), nil
+	})))
+
+	res, err := client.Schedule.Segments.Insert("141981764").
+		StartTime(time.Date(2021, 7, 1, 18, 0, 0, 0, time.UTC)).
+		Timezone("America/New_York").
+		Duration(time.Hour).
+		IsRecurring(true).
+		Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "1", res.Schedule.Segments[0].ID)
+}
+
+func TestAPI_ScheduleICalendar(t *testing.T) {
+	ics := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"PRODID:-//twitch.tv//StreamSchedule//1.0",
+		"VERSION:2.0",
+		"CALSCALE:GREGORIAN",
+		"REFRESH-INTERVAL;VALUE=DURATION:PT1H",
+		"NAME:TwitchDev",
+		"BEGIN:VEVENT",
+		"UID:e4acc724-371f-402c-81ca-23ada79759d4",
+		"DTSTAMP:20210323T040131Z",
+		"DTSTART;TZID=/America/New_York:20210701T140000",
+		"DTEND;TZID=/America/New_York:20210701T150000",
+		"SUMMARY:TwitchDev Monthly Update \\, July 1",
+		"  2021",
+		"DESCRIPTION:Science & Technology.",
+		"CATEGORIES:Science & Technology",
+		"RRULE:FREQ=WEEKLY;BYDAY=TH",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:8f6c1b4e-5d2a-4c8b-9e3f-0a1b2c3d4e5f",
+		"DTSTART:20210708T180000Z",
+		"DTEND:20210708T190000Z",
+		"SUMMARY:Q&A",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/helix/schedule/icalendar", req.URL.Path)
+		res := newMockResponse(http.StatusOK, ics)
+		res.Header.Set("Content-Type", "text/calendar")
+		return res, nil
+	})))
+
+	res, err := client.Schedule.ICalendar("141981764").Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "TwitchDev", res.Name)
+	assert.Equal(t, ics, string(res.Raw))
+	if !assert.Len(t, res.Segments, 2) {
+		return
+	}
+
+	segment := res.Segments[0]
+	assert.Equal(t, "e4acc724-371f-402c-81ca-23ada79759d4", segment.ID)
+	assert.Equal(t, "TwitchDev Monthly Update , July 1 2021", segment.Title)
+	assert.Equal(t, time.Date(2021, 7, 1, 18, 0, 0, 0, time.UTC), segment.StartTime.UTC())
+	assert.Equal(t, time.Hour, segment.EndTime.Sub(segment.StartTime))
+	assert.Equal(t, "Science & Technology", segment.Category.Name)
+	assert.True(t, segment.IsRecurring)
+
+	segment = res.Segments[1]
+	assert.Equal(t, time.Date(2021, 7, 8, 18, 0, 0, 0, time.UTC), segment.StartTime)
+	assert.Nil(t, segment.Category)
+	assert.False(t, segment.IsRecurring)
+}
+
+func TestAPI_ScheduleICalendarError(t *testing.T) {
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return newMockResponse(http.StatusNotFound, `{"error":"Not Found","status":404,"message":"segments were either not found or are not available"}`), nil
+	})))
+
+	_, err := client.Schedule.ICalendar("141981764").Do(context.Background())
+	assert.ErrorIs(t, err, api.ErrNotFound)
+}
+
+func TestAPI_ScheduleICalendarUnknownTimezone(t *testing.T) {
+	ics := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"BEGIN:VTIMEZONE",
+		"TZID:/Not/AZone",
+		"BEGIN:DAYLIGHT",
+		"TZOFFSETFROM:-0500",
+		"TZOFFSETTO:-0400",
+		"END:DAYLIGHT",
+		"BEGIN:STANDARD",
+		"TZOFFSETFROM:-0400",
+		"TZOFFSETTO:-0500",
+		"END:STANDARD",
+		"END:VTIMEZONE",
+		"BEGIN:VEVENT",
+		"UID:e4acc724-371f-402c-81ca-23ada79759d4",
+		"DTSTART;TZID=/Not/AZone:20210701T140000",
+		"DTEND;TZID=/Not/AZone:20210701T150000",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:8f6c1b4e-5d2a-4c8b-9e3f-0a1b2c3d4e5f",
+		"DTSTART;TZID=/Also/NotAZone:20210708T180000",
+		"DTEND;TZID=/Also/NotAZone:20210708T190000",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return newMockResponse(http.StatusOK, ics), nil
+	})))
+
+	res, err := client.Schedule.ICalendar("141981764").Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !assert.Len(t, res.Segments, 2) {
+		return
+	}
+	assert.Equal(t, time.Date(2021, 7, 1, 19, 0, 0, 0, time.UTC), res.Segments[0].StartTime.UTC())
+	assert.Equal(t, time.Date(2021, 7, 8, 18, 0, 0, 0, time.UTC), res.Segments[1].StartTime.UTC())
+	assert.Equal(t, time.Hour, res.Segments[1].EndTime.Sub(res.Segments[1].StartTime))
+}
@@ -1,5 +1,27 @@
 package api
 
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type SearchChannel struct {
+	ID                  string    `json:"id"`
+	BroadcasterLogin    string    `json:"broadcaster_login"`
+	DisplayName         string    `json:"display_name"`
+	BroadcasterLanguage string    `json:"broadcaster_language"`
+	GameID              string    `json:"game_id"`
+	GameName            string    `json:"game_name"`
+	IsLive              bool      `json:"is_live"`
+	Tags                []string  `json:"tags"`
+	ThumbnailURL        string    `json:"thumbnail_url"`
+	Title               string    `json:"title"`
+	StartedAt           time.Time `json:"started_at"` // Zero if the channel is not live.
+}
+
 type SearchResource struct {
 	client *Client
 }
@@ -7,3 +29,156 @@ type SearchResource struct {
 func NewSearchResource(client *Client) *SearchResource {
 	return &SearchResource{client}
 }
+
+type SearchCategoriesCall struct {
+	resource *SearchResource
+	opts     []RequestOption
+}
+
+type SearchCategoriesResponse struct {
+	Header http.Header
+	Data   []Game
+	Cursor string
+}
+
+// Categories creates a request to search for games and categories whose name matches the query.
+func (r *SearchResource) Categories(query string) *SearchCategoriesCall {
+	c := &SearchCategoriesCall{resource: r}
+	c.opts = append(c.opts, SetQueryParameter("query", query))
+	return c
+}
+
+// First sets the maximum number of categories to return per page.
+func (c *SearchCategoriesCall) First(n int) *SearchCategoriesCall {
+	c.opts = append(c.opts, SetQueryParameter("first", fmt.Sprint(n)))
+	return c
+}
+
+// After filters the results to those with a cursor value after the specified cursor.
+func (c *SearchCategoriesCall) After(cursor string) *SearchCategoriesCall {
+	c.opts = append(c.opts, SetQueryParameter("after", cursor))
+	return c
+}
+
+// Do executes the request.
+func (c *SearchCategoriesCall) Do(ctx context.Context, opts ...RequestOption) (*SearchCategoriesResponse, error) {
+	res, err := c.resource.client.doRequest(ctx, "SearchCategoriesCall", http.MethodGet, "/search/categories", nil, append(c.opts, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	data, err := decodeResponse[Game](res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SearchCategoriesResponse{
+		Header: res.Header,
+		Data:   data.Data,
+		Cursor: data.Pagination.Cursor,
+	}, nil
+}
+
+// Pages creates an iterator over the pages of categories.
+func (c *SearchCategoriesCall) Pages(opts ...RequestOption) *Iterator[*SearchCategoriesResponse] {
+	return pages(c.Do, opts, func(res *SearchCategoriesResponse) string { return res.Cursor })
+}
+
+// All returns the categories from every page, up to the limit if it is greater than 0.
+func (c *SearchCategoriesCall) All(ctx context.Context, limit int, opts ...RequestOption) ([]Game, error) {
+	return collect(ctx, c.Pages(opts...), limit, func(res *SearchCategoriesResponse) []Game {
+		return res.Data
+	})
+}
+
+type SearchChannelsCall struct {
+	resource *SearchResource
+	opts     []RequestOption
+}
+
+type SearchChannelsResponse struct {
+	Header http.Header
+	Data   []SearchChannel
+	Cursor string
+}
+
+// Channels creates a request to search for channels whose login or display name matches the query.
+func (r *SearchResource) Channels(query string) *SearchChannelsCall {
+	c := &SearchChannelsCall{resource: r}
+	c.opts = append(c.opts, SetQueryParameter("query", query))
+	return c
+}
+
+// LiveOnly filters the results to channels that are streaming.
+func (c *SearchChannelsCall) LiveOnly() *SearchChannelsCall {
+	c.opts = append(c.opts, SetQueryParameter("live_only", "true"))
+	return c
+}
+
+// First sets the maximum number of channels to return per page.
+func (c *SearchChannelsCall) First(n int) *SearchChannelsCall {
+	c.opts = append(c.opts, SetQueryParameter("first", fmt.Sprint(n)))
+	return c
+}
+
+// After filters the results to those with a cursor value after the specified cursor.
+func (c *SearchChannelsCall) After(cursor string) *SearchChannelsCall {
+	c.opts = append(c.opts, SetQueryParameter("after", cursor))
+	return c
+}
+
+// Do executes the request.
+func (c *SearchChannelsCall) Do(ctx context.Context, opts ...RequestOption) (*SearchChannelsResponse, error) {
+	res, err := c.resource.client.doRequest(ctx, "SearchChannelsCall", http.MethodGet, "/search/channels", nil, append(c.opts, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	data, err := decodeResponse[SearchChannel](res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SearchChannelsResponse{
+		Header: res.Header,
+		Data:   data.Data,
+		Cursor: data.Pagination.Cursor,
+	}, nil
+}
+
+// Pages creates an iterator over the pages of channels.
+func (c *SearchChannelsCall) Pages(opts ...RequestOption) *Iterator[*SearchChannelsResponse] {
+	return pages(c.Do, opts, func(res *SearchChannelsResponse) string { return res.Cursor })
+}
+
+// All returns the channels from every page, up to the limit if it is greater than 0.
+func (c *SearchChannelsCall) All(ctx context.Context, limit int, opts ...RequestOption) ([]SearchChannel, error) {
+	return collect(ctx, c.Pages(opts...), limit, func(res *SearchChannelsResponse) []SearchChannel {
+		return res.Data
+	})
+}
+
+// UnmarshalJSON decodes a channel, leaving StartedAt as the zero time when Twitch sends an empty string for
+// channels that are not live.
+func (c *SearchChannel) UnmarshalJSON(data []byte) error {
+	type channel SearchChannel
+	var raw struct {
+		*channel
+		StartedAt string `json:"started_at"`
+	}
+	raw.channel = (*channel)(c)
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	c.StartedAt = time.Time{}
+	if len(raw.StartedAt) > 0 {
+		t, err := time.Parse(time.RFC3339, raw.StartedAt)
+		if err != nil {
+			return err
+		}
+		c.StartedAt = t
+	}
+	return nil
+}
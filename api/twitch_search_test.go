@@ -0,0 +1,47 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/adeithe/go-twitch/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPI_SearchChannels(t *testing.T) {
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/helix/search/channels", req.URL.Path)
+		assert.Equal(t, "twitch dev", req.URL.Query().Get("query"))
+		assert.Equal(t, "true", req.URL.Query().Get("live_only"))
+		return newMockResponse(http.StatusOK, `{"data":[{"broadcaster_language":"en","broadcaster_login":"twitchdev","display_name":"TwitchDev","game_id":"509670","game_name":"Science & Technology","id":"141981764","is_live":true,"tags":["English"],"thumbnail_url":"https://static-cdn.jtvnw.net/jtv_user_pictures/twitchdev.png","title":"TwitchDev Monthly Update","started_at":"2021-03-31T20:57:26Z"},{"broadcaster_language":"en","broadcaster_login":"twitchgaming","display_name":"TwitchGaming","game_id":"","game_name":"","id":"527115020","is_live":false,"tags":[],"thumbnail_url":"","title":"","started_at":""}],"pagination":{"cursor":"abc"}}`), nil
+	})))
+
+	res, err := client.Search.Channels("twitch dev").LiveOnly().Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "abc", res.Cursor)
+	assert.Len(t, res.Data, 2)
+	assert.Equal(t, "TwitchDev", res.Data[0].DisplayName)
+	assert.Equal(t, []string{"English"}, res.Data[0].Tags)
+	assert.Equal(t, time.Date(2021, 3, 31, 20, 57, 26, 0, time.UTC), res.Data[0].StartedAt)
+	assert.False(t, res.Data[1].IsLive)
+	assert.True(t, res.Data[1].StartedAt.IsZero())
+}
+
+func TestAPI_SearchCategories(t *testing.T) {
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/helix/search/categories", req.URL.Path)
+		assert.Equal(t, "fort", req.URL.Query().Get("query"))
+		return newMockResponse(http.StatusOK, `{"data":[{"id":"33214","name":"Fortnite","box_art_url":"https://static-cdn.jtvnw.net/ttv-boxart/33214-52x72.jpg"}],"pagination":{}}`), nil
+	})))
+
+	categories, err := client.Search.Categories("fort").All(context.Background(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, categories, 1)
+	assert.Equal(t, "Fortnite", categories[0].Name)
+}
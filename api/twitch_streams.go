@@ -35,6 +35,7 @@ func NewStreamsResource(client *Client) *StreamsResource {
 type StreamsListCall struct {
 	resource *StreamsResource
 	opts     []RequestOption
+	lookup   lookup
 }
 
 type StreamsListResponse struct {
@@ -51,18 +52,20 @@ func (r *StreamsResource) List() *StreamsListCall {
 }
 
 // UserID filters the results to the specified user IDs.
+//
+// Lookups of more than 100 IDs and usernames combined are split into several requests, each of which fetches every
+// page of results.
 func (c *StreamsListCall) UserID(ids []string) *StreamsListCall {
-	for _, id := range ids {
-		c.opts = append(c.opts, AddQueryParameter("user_id", id))
-	}
+	c.lookup.add("user_id", ids...)
 	return c
 }
 
 // Username filters the results to the specified usernames.
+//
+// Lookups of more than 100 IDs and usernames combined are split into several requests, each of which fetches every
+// page of results.
 func (c *StreamsListCall) Username(usernames []string) *StreamsListCall {
-	for _, username := range usernames {
-		c.opts = append(c.opts, AddQueryParameter("user_login", username))
-	}
+	c.lookup.add("user_login", usernames...)
 	return c
 }
 
@@ -112,20 +115,38 @@ func (c *StreamsListCall) After(cursor string) *StreamsListCall {
 
 // Do executes the request.
 func (c *StreamsListCall) Do(ctx context.Context, opts ...RequestOption) (*StreamsListResponse, error) {
-	res, err := c.resource.client.doRequest(ctx, http.MethodGet, "/streams", nil, append(opts, c.opts...)...)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	data, err := decodeResponse[Stream](res)
+	page, err := doBatched(ctx, c.resource.client, c.lookup, func(ctx context.Context, lookup []RequestOption) (*batchPage[Stream], error) {
+		res, err := c.resource.client.doRequest(ctx, "StreamsListCall", http.MethodGet, "/streams", nil, joinOptions(c.opts, lookup, opts)...)
+		if err != nil {
+			return nil, err
+		}
+		defer res.Body.Close()
+
+		data, err := decodeResponse[Stream](res)
+		if err != nil {
+			return nil, err
+		}
+		return &batchPage[Stream]{Header: res.Header, Data: data.Data, Cursor: data.Pagination.Cursor}, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	return &StreamsListResponse{
-		Header: res.Header,
-		Data:   data.Data,
-		Cursor: data.Pagination.Cursor,
+		Header: page.Header,
+		Data:   page.Data,
+		Cursor: page.Cursor,
 	}, nil
 }
+
+// Pages creates an iterator over the pages of streams.
+func (c *StreamsListCall) Pages(opts ...RequestOption) *Iterator[*StreamsListResponse] {
+	return pages(c.Do, opts, func(res *StreamsListResponse) string { return res.Cursor })
+}
+
+// All returns the streams from every page, up to the limit if it is greater than 0.
+func (c *StreamsListCall) All(ctx context.Context, limit int, opts ...RequestOption) ([]Stream, error) {
+	return collect(ctx, c.Pages(opts...), limit, func(res *StreamsListResponse) []Stream {
+		return res.Data
+	})
+}
@@ -1,5 +1,33 @@
 package api
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Tiers of a subscription
+const (
+	SubscriptionTier1 = "1000"
+	SubscriptionTier2 = "2000"
+	SubscriptionTier3 = "3000"
+)
+
+type Subscription struct {
+	BroadcasterID          string `json:"broadcaster_id"`
+	BroadcasterLogin       string `json:"broadcaster_login"`
+	BroadcasterDisplayName string `json:"broadcaster_name"`
+	GifterID               string `json:"gifter_id"`
+	GifterLogin            string `json:"gifter_login"`
+	GifterDisplayName      string `json:"gifter_name"`
+	IsGift                 bool   `json:"is_gift"`
+	PlanName               string `json:"plan_name"` // Only present when listing the subscriptions of a broadcaster.
+	Tier                   string `json:"tier"`
+	UserID                 string `json:"user_id"`    // Only present when listing the subscriptions of a broadcaster.
+	UserLogin              string `json:"user_login"` // Only present when listing the subscriptions of a broadcaster.
+	UserDisplayName        string `json:"user_name"`  // Only present when listing the subscriptions of a broadcaster.
+}
+
 type SubscriptionsResource struct {
 	client *Client
 }
@@ -7,3 +35,124 @@ type SubscriptionsResource struct {
 func NewSubscriptionsResource(client *Client) *SubscriptionsResource {
 	return &SubscriptionsResource{client}
 }
+
+type SubscriptionsListCall struct {
+	resource *SubscriptionsResource
+	opts     []RequestOption
+}
+
+type SubscriptionsListResponse struct {
+	Header http.Header
+	Data   []Subscription
+	Total  int // The number of users subscribed to the broadcaster.
+	Points int // The subscriber points of the broadcaster, where tier 2 and 3 subscriptions are worth more points.
+	Cursor string
+}
+
+// List creates a request to list the users subscribed to a broadcaster.
+func (r *SubscriptionsResource) List(broadcasterId string) *SubscriptionsListCall {
+	c := &SubscriptionsListCall{resource: r}
+	c.opts = append(c.opts, SetQueryParameter("broadcaster_id", broadcasterId))
+	return c
+}
+
+// UserID filters the results to the specified users, up to 100.
+func (c *SubscriptionsListCall) UserID(ids []string) *SubscriptionsListCall {
+	for _, id := range ids {
+		c.opts = append(c.opts, AddQueryParameter("user_id", id))
+	}
+	return c
+}
+
+// First sets the maximum number of subscriptions to return per page.
+func (c *SubscriptionsListCall) First(n int) *SubscriptionsListCall {
+	c.opts = append(c.opts, SetQueryParameter("first", fmt.Sprint(n)))
+	return c
+}
+
+// Before filters the results to those with a cursor value before the specified cursor.
+func (c *SubscriptionsListCall) Before(cursor string) *SubscriptionsListCall {
+	c.opts = append(c.opts, SetQueryParameter("before", cursor))
+	return c
+}
+
+// After filters the results to those with a cursor value after the specified cursor.
+func (c *SubscriptionsListCall) After(cursor string) *SubscriptionsListCall {
+	c.opts = append(c.opts, SetQueryParameter("after", cursor))
+	return c
+}
+
+// Do executes the request.
+func (c *SubscriptionsListCall) Do(ctx context.Context, opts ...RequestOption) (*SubscriptionsListResponse, error) {
+	res, err := c.resource.client.doRequest(ctx, "SubscriptionsListCall", http.MethodGet, "/subscriptions", nil, append(c.opts, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	data, err := decodeResponse[Subscription](res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SubscriptionsListResponse{
+		Header: res.Header,
+		Data:   data.Data,
+		Total:  data.Total,
+		Points: data.Points,
+		Cursor: data.Pagination.Cursor,
+	}, nil
+}
+
+// Pages creates an iterator over the pages of subscriptions.
+func (c *SubscriptionsListCall) Pages(opts ...RequestOption) *Iterator[*SubscriptionsListResponse] {
+	return pages(c.Do, opts, func(res *SubscriptionsListResponse) string { return res.Cursor })
+}
+
+// All returns the subscriptions from every page, up to the limit if it is greater than 0.
+func (c *SubscriptionsListCall) All(ctx context.Context, limit int, opts ...RequestOption) ([]Subscription, error) {
+	return collect(ctx, c.Pages(opts...), limit, func(res *SubscriptionsListResponse) []Subscription {
+		return res.Data
+	})
+}
+
+type SubscriptionsCheckCall struct {
+	resource *SubscriptionsResource
+	opts     []RequestOption
+}
+
+type SubscriptionsCheckResponse struct {
+	Header http.Header
+	Data   []Subscription
+}
+
+// Check creates a request to check whether a user is subscribed to a broadcaster.
+//
+// The access token must belong to the user.
+func (r *SubscriptionsResource) Check(broadcasterId, userId string) *SubscriptionsCheckCall {
+	c := &SubscriptionsCheckCall{resource: r}
+	c.opts = append(c.opts, SetQueryParameter("broadcaster_id", broadcasterId))
+	c.opts = append(c.opts, SetQueryParameter("user_id", userId))
+	return c
+}
+
+// Do executes the request.
+//
+// If the user is not subscribed to the broadcaster, the error matches ErrNotFound.
+func (c *SubscriptionsCheckCall) Do(ctx context.Context, opts ...RequestOption) (*SubscriptionsCheckResponse, error) {
+	res, err := c.resource.client.doRequest(ctx, "SubscriptionsCheckCall", http.MethodGet, "/subscriptions/user", nil, append(c.opts, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	data, err := decodeResponse[Subscription](res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SubscriptionsCheckResponse{
+		Header: res.Header,
+		Data:   data.Data,
+	}, nil
+}
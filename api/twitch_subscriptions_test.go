@@ -0,0 +1,60 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/adeithe/go-twitch/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPI_SubscriptionsList(t *testing.T) {
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/helix/subscriptions", req.URL.Path)
+		assert.Equal(t, "141981764", req.URL.Query().Get("broadcaster_id"))
+		if req.URL.Query().Get("after") == "" {
+			return newMockResponse(http.StatusOK, `{"data":[{"broadcaster_id":"141981764","broadcaster_login":"twitchdev","broadcaster_name":"TwitchDev","gifter_id":"12826","gifter_login":"twitch","gifter_name":"Twitch","is_gift":true,"tier":"1000","plan_name":"Channel Subscription (twitchdev)","user_id":"527115020","user_name":"twitchgaming","user_login":"twitchgaming"}],"pagination":{"cursor":"abc"},"total":3,"points":6}`), nil
+		}
+		return newMockResponse(http.StatusOK, `{"data":[{"broadcaster_id":"141981764","is_gift":false,"tier":"2000","user_id":"1"},{"broadcaster_id":"141981764","is_gift":false,"tier":"1000","user_id":"2"}],"pagination":{},"total":3,"points":6}`), nil
+	})))
+
+	res, err := client.Subscriptions.List("141981764").Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 3, res.Total)
+	assert.Equal(t, 6, res.Points)
+	assert.Equal(t, "abc", res.Cursor)
+	assert.True(t, res.Data[0].IsGift)
+	assert.Equal(t, "Twitch", res.Data[0].GifterDisplayName)
+
+	subs, err := client.Subscriptions.List("141981764").All(context.Background(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tiers := make(map[string]int)
+	for _, sub := range subs {
+		tiers[sub.Tier]++
+	}
+	assert.Equal(t, map[string]int{api.SubscriptionTier1: 2, api.SubscriptionTier2: 1}, tiers)
+}
+
+func TestAPI_SubscriptionsCheck(t *testing.T) {
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/helix/subscriptions/user", req.URL.Path)
+		if req.URL.Query().Get("user_id") == "1" {
+			return newMockResponse(http.StatusOK, `{"data":[{"broadcaster_id":"141981764","broadcaster_name":"TwitchDev","broadcaster_login":"twitchdev","is_gift":false,"tier":"3000"}]}`), nil
+		}
+		return newMockResponse(http.StatusNotFound, `{"error":"Not Found","status":404,"message":"twitchdev has no subscription to twitchgaming"}`), nil
+	})))
+
+	res, err := client.Subscriptions.Check("141981764", "1").Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, api.SubscriptionTier3, res.Data[0].Tier)
+
+	_, err = client.Subscriptions.Check("141981764", "2").Do(context.Background())
+	assert.ErrorIs(t, err, api.ErrNotFound)
+}
@@ -1,5 +1,41 @@
 package api
 
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+type Team struct {
+	ID                 string       `json:"id"`
+	Name               string       `json:"team_name"`
+	DisplayName        string       `json:"team_display_name"`
+	Info               string       `json:"info"` // An HTML description of the team.
+	ThumbnailURL       string       `json:"thumbnail_url"`
+	BackgroundImageURL string       `json:"background_image_url"`
+	BannerURL          string       `json:"banner"`
+	Users              []TeamMember `json:"users"` // Only present when listing teams by name or ID.
+	CreatedAt          TeamTime     `json:"created_at"`
+	UpdatedAt          TeamTime     `json:"updated_at"`
+}
+
+type TeamMember struct {
+	UserID          string `json:"user_id"`
+	UserLogin       string `json:"user_login"`
+	UserDisplayName string `json:"user_name"`
+}
+
+type ChannelTeam struct {
+	Team
+	BroadcasterID          string `json:"broadcaster_id"`
+	BroadcasterLogin       string `json:"broadcaster_login"`
+	BroadcasterDisplayName string `json:"broadcaster_name"`
+}
+
+// TeamTime is a timestamp of a team, which Twitch sends as "2006-01-02 15:04:05" in UTC.
+type TeamTime time.Time
+
 type TeamsResource struct {
 	client *Client
 }
@@ -7,3 +43,107 @@ type TeamsResource struct {
 func NewTeamsResource(client *Client) *TeamsResource {
 	return &TeamsResource{client}
 }
+
+type TeamsListCall struct {
+	resource *TeamsResource
+	opts     []RequestOption
+}
+
+type TeamsListResponse struct {
+	Header http.Header
+	Data   []Team
+}
+
+// List creates a request to get a team and its members.
+//
+// One of Name or ID must be specified.
+func (r *TeamsResource) List() *TeamsListCall {
+	return &TeamsListCall{resource: r}
+}
+
+// Name sets the name of the team to get, such as "livecoders".
+func (c *TeamsListCall) Name(name string) *TeamsListCall {
+	c.opts = append(c.opts, SetQueryParameter("name", name))
+	return c
+}
+
+// ID sets the ID of the team to get.
+func (c *TeamsListCall) ID(id string) *TeamsListCall {
+	c.opts = append(c.opts, SetQueryParameter("id", id))
+	return c
+}
+
+// Do executes the request.
+func (c *TeamsListCall) Do(ctx context.Context, opts ...RequestOption) (*TeamsListResponse, error) {
+	res, err := c.resource.client.doRequest(ctx, "TeamsListCall", http.MethodGet, "/teams", nil, append(c.opts, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	data, err := decodeResponse[Team](res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TeamsListResponse{
+		Header: res.Header,
+		Data:   data.Data,
+	}, nil
+}
+
+type TeamsChannelCall struct {
+	resource *TeamsResource
+	opts     []RequestOption
+}
+
+type TeamsChannelResponse struct {
+	Header http.Header
+	Data   []ChannelTeam
+}
+
+// Channel creates a request to list the teams that a broadcaster is a member of.
+func (r *TeamsResource) Channel(broadcasterId string) *TeamsChannelCall {
+	c := &TeamsChannelCall{resource: r}
+	c.opts = append(c.opts, SetQueryParameter("broadcaster_id", broadcasterId))
+	return c
+}
+
+// Do executes the request.
+func (c *TeamsChannelCall) Do(ctx context.Context, opts ...RequestOption) (*TeamsChannelResponse, error) {
+	res, err := c.resource.client.doRequest(ctx, "TeamsChannelCall", http.MethodGet, "/teams/channel", nil, append(c.opts, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	data, err := decodeResponse[ChannelTeam](res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TeamsChannelResponse{
+		Header: res.Header,
+		Data:   data.Data,
+	}, nil
+}
+
+func (t *TeamTime) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	parsed, err := time.Parse("2006-01-02 15:04:05", str)
+	if err != nil {
+		if parsed, err = time.Parse(time.RFC3339, str); err != nil {
+			return err
+		}
+	}
+	*t = TeamTime(parsed)
+	return nil
+}
+
+func (t TeamTime) AsTime() time.Time {
+	return time.Time(t)
+}
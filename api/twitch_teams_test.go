@@ -0,0 +1,46 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/adeithe/go-twitch/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPI_TeamsList(t *testing.T) {
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/helix/teams", req.URL.Path)
+		assert.Equal(t, "livecoders", req.URL.Query().Get("name"))
+		return newMockResponse(http.StatusOK, `{"data":[{"users":[{"user_id":"278217731","user_name":"mastermndio","user_login":"mastermndio"},{"user_id":"41284990","user_name":"jenninexus","user_login":"jenninexus"}],"background_image_url":null,"banner":null,"created_at":"2019-02-11 12:09:22","updated_at":"2020-11-18 15:56:41","info":"<p>Live Coders</p>","thumbnail_url":"https://static-cdn.jtvnw.net/jtv_user_pictures/livecoders.png","team_name":"livecoders","team_display_name":"Live Coders","id":"6358"}]}`), nil
+	})))
+
+	res, err := client.Teams.List().Name("livecoders").Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	team := res.Data[0]
+	assert.Equal(t, "Live Coders", team.DisplayName)
+	assert.Empty(t, team.BannerURL)
+	assert.Len(t, team.Users, 2)
+	assert.Equal(t, "mastermndio", team.Users[0].UserLogin)
+	assert.Equal(t, time.Date(2019, 2, 11, 12, 9, 22, 0, time.UTC), team.CreatedAt.AsTime())
+}
+
+func TestAPI_TeamsChannel(t *testing.T) {
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/helix/teams/channel", req.URL.Path)
+		assert.Equal(t, "96909659", req.URL.Query().Get("broadcaster_id"))
+		return newMockResponse(http.StatusOK, `{"data":[{"broadcaster_id":"96909659","broadcaster_name":"CSharpFritz","broadcaster_login":"csharpfritz","background_image_url":null,"banner":null,"created_at":"2019-02-11 12:09:22","updated_at":"2020-11-18 15:56:41","info":"<p>Live Coders</p>","thumbnail_url":"https://static-cdn.jtvnw.net/jtv_user_pictures/livecoders.png","team_name":"livecoders","team_display_name":"Live Coders","id":"6358"}]}`), nil
+	})))
+
+	res, err := client.Teams.Channel("96909659").Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "csharpfritz", res.Data[0].BroadcasterLogin)
+	assert.Equal(t, "6358", res.Data[0].ID)
+	assert.Equal(t, "livecoders", res.Data[0].Name)
+}
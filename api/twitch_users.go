@@ -30,6 +30,7 @@ func NewUsersResource(client *Client) *UsersResource {
 type UsersListCall struct {
 	resource *UsersResource
 	opts     []RequestOption
+	lookup   lookup
 }
 
 type UsersListResponse struct {
@@ -45,36 +46,44 @@ func (r *UsersResource) List() *UsersListCall {
 }
 
 // ID filters the results to the specified user IDs.
+//
+// Lookups of more than 100 IDs and usernames combined are split into several requests, each of which fetches every
+// page of results.
 func (c *UsersListCall) ID(ids []string) *UsersListCall {
-	for _, id := range ids {
-		c.opts = append(c.opts, AddQueryParameter("id", id))
-	}
+	c.lookup.add("id", ids...)
 	return c
 }
 
 // Login filters the results to the specified usernames.
+//
+// Lookups of more than 100 IDs and usernames combined are split into several requests, each of which fetches every
+// page of results.
 func (c *UsersListCall) Login(logins []string) *UsersListCall {
-	for _, login := range logins {
-		c.opts = append(c.opts, AddQueryParameter("login", login))
-	}
+	c.lookup.add("login", logins...)
 	return c
 }
 
 // Do executes the request.
 func (c *UsersListCall) Do(ctx context.Context, opts ...RequestOption) (*UsersListResponse, error) {
-	res, err := c.resource.client.doRequest(ctx, http.MethodGet, "/users", nil, append(opts, c.opts...)...)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
+	page, err := doBatched(ctx, c.resource.client, c.lookup, func(ctx context.Context, lookup []RequestOption) (*batchPage[User], error) {
+		res, err := c.resource.client.doRequest(ctx, "UsersListCall", http.MethodGet, "/users", nil, joinOptions(c.opts, lookup, opts)...)
+		if err != nil {
+			return nil, err
+		}
+		defer res.Body.Close()
 
-	data, err := decodeResponse[User](res)
+		data, err := decodeResponse[User](res)
+		if err != nil {
+			return nil, err
+		}
+		return &batchPage[User]{Header: res.Header, Data: data.Data, Cursor: data.Pagination.Cursor}, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	return &UsersListResponse{
-		Header: res.Header,
-		Data:   data.Data,
+		Header: page.Header,
+		Data:   page.Data,
 	}, nil
 }
@@ -123,7 +123,7 @@ func (c *VideosListCall) After(cursor string) *VideosListCall {
 }
 
 func (c *VideosListCall) Do(ctx context.Context, opts ...RequestOption) (*VideosListResponse, error) {
-	res, err := c.resource.client.doRequest(ctx, http.MethodGet, "/videos", nil, append(c.opts, opts...)...)
+	res, err := c.resource.client.doRequest(ctx, "VideosListCall", http.MethodGet, "/videos", nil, append(c.opts, opts...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -141,6 +141,18 @@ func (c *VideosListCall) Do(ctx context.Context, opts ...RequestOption) (*Videos
 	}, nil
 }
 
+// Pages creates an iterator over the pages of videos.
+func (c *VideosListCall) Pages(opts ...RequestOption) *Iterator[*VideosListResponse] {
+	return pages(c.Do, opts, func(res *VideosListResponse) string { return res.Cursor })
+}
+
+// All returns the videos from every page, up to the limit if it is greater than 0.
+func (c *VideosListCall) All(ctx context.Context, limit int, opts ...RequestOption) ([]Video, error) {
+	return collect(ctx, c.Pages(opts...), limit, func(res *VideosListResponse) []Video {
+		return res.Data
+	})
+}
+
 type VideosDeleteCall struct {
 	resource *VideosResource
 	opts     []RequestOption
@@ -161,7 +173,7 @@ func (r *VideosResource) Delete(ids []string) *VideosDeleteCall {
 }
 
 func (c *VideosDeleteCall) Do(ctx context.Context, opts ...RequestOption) (*VideosDeleteResponse, error) {
-	res, err := c.resource.client.doRequest(ctx, http.MethodDelete, "/videos", nil, append(c.opts, opts...)...)
+	res, err := c.resource.client.doRequest(ctx, "VideosDeleteCall", http.MethodDelete, "/videos", nil, append(c.opts, opts...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -50,7 +50,7 @@ func (c *WhispersInsertCall) Do(ctx context.Context, opts ...RequestOption) erro
 		return err
 	}
 
-	res, err := c.resource.client.doRequest(ctx, http.MethodPost, "/whispers", bytes.NewReader(bs), opts...)
+	res, err := c.resource.client.doRequest(ctx, "WhispersInsertCall", http.MethodPost, "/whispers", bytes.NewReader(bs), append(c.opts, opts...)...)
 	if err != nil {
 		return err
 	}
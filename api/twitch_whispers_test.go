@@ -0,0 +1,30 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/adeithe/go-twitch/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPI_WhispersInsert(t *testing.T) {
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, "/helix/whispers", req.URL.Path)
+		assert.Equal(t, "123", req.URL.Query().Get("from_user_id"))
+		assert.Equal(t, "456", req.URL.Query().Get("to_user_id"))
+
+		var body map[string]string
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "Hello", body["message"])
+		return newMockResponse(http.StatusNoContent, ``), nil
+	})))
+
+	err := client.Whispers.Insert("123", "456").Message("Hello").Do(context.Background())
+	assert.NoError(t, err)
+}
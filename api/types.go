@@ -1,9 +1,14 @@
 package api
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"time"
 )
 
 type HTTPClient interface {
@@ -14,56 +19,214 @@ type ResponseData[T any] struct {
 	Total  int `json:"total,omitempty"`  // Only present in some endpoints.
 	Points int `json:"points,omitempty"` // Only present in some endpoints.
 
-	Data       []T        `json:"data"`
-	Pagination Pagination `json:"pagination,omitempty"`
+	TotalCost    int `json:"total_cost,omitempty"`     // Only present in some endpoints.
+	MaxTotalCost int `json:"max_total_cost,omitempty"` // Only present in some endpoints.
+
+	Data       []T             `json:"data"`
+	Pagination Pagination      `json:"pagination,omitempty"`
+	Errors     json.RawMessage `json:"errors,omitempty"`   // Only present in some endpoints.
+	Template   string          `json:"template,omitempty"` // Only present in some endpoints.
 
 	Status  int    `json:"status"`            // If not provided by Twitch, defaults to HTTP status code.
 	Code    string `json:"error"`             // If not provided by Twitch, defaults to HTTP status text.
 	Message string `json:"message,omitempty"` // Only present if status is non-200
 }
 
+// responseData has the fields of ResponseData without its methods so that it can be decoded by encoding/json.
+type responseData[T any] ResponseData[T]
+
+// UnmarshalJSON decodes the response, accepting an object for data as some endpoints such as
+// Get Channel Stream Schedule respond with a single item instead of a list.
+func (r *ResponseData[T]) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		*responseData[T]
+		Data json.RawMessage `json:"data"`
+	}
+	raw.responseData = (*responseData[T])(r)
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	data := bytes.TrimSpace(raw.Data)
+	if len(data) > 0 && data[0] == '{' {
+		var item T
+		if err := json.Unmarshal(data, &item); err != nil {
+			return err
+		}
+		r.Data = []T{item}
+		return nil
+	}
+	if len(data) > 0 {
+		return json.Unmarshal(data, &r.Data)
+	}
+	return nil
+}
+
 type Pagination struct {
 	Cursor string `json:"cursor,omitempty"`
 }
 
+// Errors that an APIError matches with errors.Is based on its HTTP status code
+var (
+	ErrBadRequest   = errors.New("twitchapi: bad request")
+	ErrUnauthorized = errors.New("twitchapi: unauthorized")
+	ErrForbidden    = errors.New("twitchapi: forbidden")
+	ErrNotFound     = errors.New("twitchapi: not found")
+	ErrConflict     = errors.New("twitchapi: conflict")
+	ErrRateLimited  = errors.New("twitchapi: rate limited")
+	ErrServerError  = errors.New("twitchapi: server error")
+)
+
+// maxErrorBodySize is the most of a response body that is kept in an error.
+const maxErrorBodySize = 4096
+
+// APIError is returned when Twitch responds with an error.
+//
+//	if errors.Is(err, api.ErrNotFound) {
+//		return nil
+//	}
+//	var apiErr *api.APIError
+//	if errors.As(err, &apiErr) && apiErr.RetryAfter > 0 {
+//		time.Sleep(apiErr.RetryAfter)
+//	}
 type APIError struct {
 	Status  int    `json:"status"`
 	Code    string `json:"error"`
 	Message string `json:"message"`
+
+	Method     string        `json:"-"` // The method of the request that failed.
+	Path       string        `json:"-"` // The path of the request that failed.
+	RetryAfter time.Duration `json:"-"` // How long to wait before trying again, if known.
+	Body       []byte        `json:"-"` // The response body if it could not be decoded, such as an HTML error page.
+}
+
+// DecodeError is returned when a successful response could not be decoded.
+type DecodeError struct {
+	Status int
+	Method string
+	Path   string
+	Body   []byte
+	Err    error
 }
 
 func decodeResponse[T any](res *http.Response) (*ResponseData[T], error) {
-	var data ResponseData[T]
-	if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
 		return nil, err
 	}
 
+	var data ResponseData[T]
+	// Some endpoints respond with 204 No Content, which is not an error.
+	if len(bytes.TrimSpace(body)) > 0 {
+		if err := json.Unmarshal(body, &data); err != nil {
+			if res.StatusCode >= http.StatusBadRequest {
+				return nil, newAPIError(res, APIError{}, body)
+			}
+			method, path := requestOf(res)
+			return nil, &DecodeError{res.StatusCode, method, path, truncateBody(body), err}
+		}
+	}
+
 	if data.Status == 0 {
 		data.Status = res.StatusCode
 		data.Code = http.StatusText(res.StatusCode)
 	}
 
-	if err := data.asError(); err != nil {
-		return nil, err
+	if data.Status >= http.StatusBadRequest {
+		return nil, newAPIError(res, APIError{Status: data.Status, Code: data.Code, Message: data.Message}, nil)
 	}
 	return &data, nil
 }
 
-func (data ResponseData[T]) asError() error {
-	if data.Status <= 400 {
-		return nil
+// newAPIError fills in the details of an error from the response it was returned with.
+func newAPIError(res *http.Response, apiErr APIError, body []byte) *APIError {
+	if apiErr.Status == 0 {
+		apiErr.Status = res.StatusCode
+	}
+	if apiErr.Code == "" {
+		apiErr.Code = http.StatusText(apiErr.Status)
 	}
-	return &APIError{data.Status, data.Code, data.Message}
+	apiErr.Method, apiErr.Path = requestOf(res)
+	apiErr.RetryAfter = retryAfter(res)
+	if len(body) > 0 {
+		apiErr.Body = truncateBody(body)
+	}
+	return &apiErr
+}
+
+// requestOf returns the method and path of the request a response was returned for.
+func requestOf(res *http.Response) (string, string) {
+	if res.Request == nil || res.Request.URL == nil {
+		return "", ""
+	}
+	return res.Request.Method, res.Request.URL.Path
+}
+
+// retryAfter returns how long Twitch asked to wait before the request is sent again.
+func retryAfter(res *http.Response) time.Duration {
+	if seconds, err := strconv.Atoi(res.Header.Get("Retry-After")); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if res.StatusCode != http.StatusTooManyRequests {
+		return 0
+	}
+	reset, err := strconv.ParseInt(res.Header.Get(HeaderRatelimitReset), 10, 64)
+	if err != nil {
+		return 0
+	}
+	if d := time.Until(time.Unix(reset, 0)); d > 0 {
+		return d
+	}
+	return 0
+}
+
+func truncateBody(body []byte) []byte {
+	if len(body) > maxErrorBodySize {
+		body = body[:maxErrorBodySize]
+	}
+	return append([]byte(nil), body...)
 }
 
 func (err APIError) Error() string {
-	return fmt.Sprintf("twitchapi: %d %s - %s", err.Status, err.Code, err.Message)
+	if err.Method == "" {
+		return fmt.Sprintf("twitchapi: %d %s - %s", err.Status, err.Code, err.Message)
+	}
+	return fmt.Sprintf("twitchapi: %s %s: %d %s - %s", err.Method, err.Path, err.Status, err.Code, err.Message)
+}
+
+// Is reports whether the error matches one of the sentinel errors for its status code.
+func (err APIError) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return err.Status == http.StatusBadRequest
+	case ErrUnauthorized:
+		return err.Status == http.StatusUnauthorized
+	case ErrForbidden:
+		return err.Status == http.StatusForbidden
+	case ErrNotFound:
+		return err.Status == http.StatusNotFound
+	case ErrConflict:
+		return err.Status == http.StatusConflict
+	case ErrRateLimited:
+		return err.Status == http.StatusTooManyRequests
+	case ErrServerError:
+		return err.Status >= http.StatusInternalServerError
+	}
+	return false
+}
+
+func (err *DecodeError) Error() string {
+	return fmt.Sprintf("twitchapi: %s %s: could not decode %d response: %v", err.Method, err.Path, err.Status, err.Err)
+}
+
+func (err *DecodeError) Unwrap() error {
+	return err.Err
 }
 
 // CodeOf returns the HTTP status code of the given error.
 // If the error is not an API error, it returns http.StatusInternalServerError.
 func CodeOf(err error) int {
-	if apiErr, ok := err.(*APIError); ok {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
 		return apiErr.Status
 	}
 	return http.StatusInternalServerError
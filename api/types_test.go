@@ -0,0 +1,94 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/adeithe/go-twitch/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPI_ErrorSentinels(t *testing.T) {
+	tests := []struct {
+		Status   int
+		Expected error
+	}{
+		{http.StatusBadRequest, api.ErrBadRequest},
+		{http.StatusUnauthorized, api.ErrUnauthorized},
+		{http.StatusForbidden, api.ErrForbidden},
+		{http.StatusNotFound, api.ErrNotFound},
+		{http.StatusConflict, api.ErrConflict},
+		{http.StatusServiceUnavailable, api.ErrServerError},
+	}
+
+	for _, test := range tests {
+		client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+			return newMockResponse(test.Status, fmt.Sprintf(`{"error":%q,"status":%d,"message":"failed"}`, http.StatusText(test.Status), test.Status)), nil
+		})))
+
+		_, err := client.Users.List().Do(context.Background())
+		assert.ErrorIs(t, err, test.Expected)
+		assert.Equal(t, test.Status, api.CodeOf(fmt.Errorf("wrapped: %w", err)))
+
+		var apiErr *api.APIError
+		if assert.True(t, errors.As(err, &apiErr)) {
+			assert.Equal(t, http.MethodGet, apiErr.Method)
+			assert.Equal(t, "/helix/users", apiErr.Path)
+			assert.Equal(t, "failed", apiErr.Message)
+		}
+	}
+}
+
+func TestAPI_ErrorRetryAfter(t *testing.T) {
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		res := newMockResponse(http.StatusTooManyRequests, `{"error":"Too Many Requests","status":429,"message":""}`)
+		res.Header.Set(api.HeaderRatelimitReset, strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10))
+		return res, nil
+	})))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+	_, err := client.Bits.Cheermotes.List().Do(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	client = api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		res := newMockResponse(http.StatusServiceUnavailable, "")
+		res.Header.Set("Retry-After", "30")
+		return res, nil
+	})))
+	_, err = client.Bits.Cheermotes.List().Do(context.Background())
+	var apiErr *api.APIError
+	if assert.ErrorAs(t, err, &apiErr) {
+		assert.ErrorIs(t, err, api.ErrServerError)
+		assert.Equal(t, time.Second*30, apiErr.RetryAfter)
+	}
+}
+
+func TestAPI_ErrorBody(t *testing.T) {
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path == "/helix/users" {
+			return newMockResponse(http.StatusBadGateway, "<html><body>502 Bad Gateway</body></html>"), nil
+		}
+		return newMockResponse(http.StatusOK, "<html></html>"), nil
+	})))
+
+	_, err := client.Users.List().Do(context.Background())
+	var apiErr *api.APIError
+	if assert.ErrorAs(t, err, &apiErr) {
+		assert.Equal(t, http.StatusBadGateway, apiErr.Status)
+		assert.Equal(t, "Bad Gateway", apiErr.Code)
+		assert.Equal(t, "<html><body>502 Bad Gateway</body></html>", string(apiErr.Body))
+	}
+
+	_, err = client.Streams.List().Do(context.Background())
+	var decodeErr *api.DecodeError
+	if assert.ErrorAs(t, err, &decodeErr) {
+		assert.Equal(t, "/helix/streams", decodeErr.Path)
+		assert.Equal(t, "<html></html>", string(decodeErr.Body))
+	}
+}
@@ -0,0 +1,449 @@
+package eventsub
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/adeithe/go-twitch/api"
+)
+
+// Conduit stores data about EventSub WebSocket connections that are bound to the shards of a conduit
+type Conduit struct {
+	client   *api.Client
+	opts     []api.RequestOption
+	id       string
+	url      string
+	length   int
+	interval time.Duration
+	shards   map[int]*Conn
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	onShardNotification []func(int, Notification)
+	onShardRevocation   []func(int, Subscription)
+	onShardAssign       []func(int, string)
+	onShardReconnect    []func(int)
+	onShardDisconnect   []func(int)
+	onError             []func(error)
+
+	mx    sync.Mutex
+	start sync.Mutex
+	wg    sync.WaitGroup
+}
+
+// IConduit interface for methods used by the EventSub conduit shard manager
+type IConduit interface {
+	SetID(string)
+	SetURL(string)
+	SetShardCount(int)
+	SetHealthCheckInterval(time.Duration)
+	ID() string
+	GetNumShards() int
+	GetShard(int) (*Conn, bool)
+
+	Start(context.Context) error
+	Close()
+
+	OnShardNotification(func(int, Notification))
+	OnShardRevocation(func(int, Subscription))
+	OnShardAssign(func(int, string))
+	OnShardReconnect(func(int))
+	OnShardDisconnect(func(int))
+	OnError(func(error))
+}
+
+var _ IConduit = &Conduit{}
+
+// NewConduit creates a shard manager that uses the provided API client to manage a conduit
+//
+// Conduits can only be managed with an app access token. The request options are passed to every
+// API request made by the manager and may be used to provide one.
+//
+// See: https://dev.twitch.tv/docs/eventsub/handling-conduit-events
+func NewConduit(client *api.Client, opts ...api.RequestOption) *Conduit {
+	return &Conduit{
+		client:   client,
+		opts:     opts,
+		length:   1,
+		interval: time.Minute,
+	}
+}
+
+// SetID sets the ID of an existing conduit to manage
+//
+// If no ID is set, a new conduit will be created when the manager is started.
+func (c *Conduit) SetID(id string) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.id = id
+}
+
+// SetURL changes the URL each shard connection will be opened to
+func (c *Conduit) SetURL(url string) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.url = url
+}
+
+// SetShardCount sets the number of shards the conduit will be created or resized with
+//
+// Default: 1
+func (c *Conduit) SetShardCount(n int) {
+	if n < 1 {
+		n = 1
+	}
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.length = n
+}
+
+// SetHealthCheckInterval changes how often the status of every shard is checked with the API
+//
+// Default: 1 minute
+func (c *Conduit) SetHealthCheckInterval(d time.Duration) {
+	if d <= 0 {
+		d = time.Minute
+	}
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.interval = d
+}
+
+// ID returns the ID of the conduit being managed
+func (c *Conduit) ID() string {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	return c.id
+}
+
+// GetNumShards returns the number of shards with an open connection
+func (c *Conduit) GetNumShards() (n int) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	for _, conn := range c.shards {
+		if conn.IsConnected() {
+			n++
+		}
+	}
+	return
+}
+
+// GetShard returns the connection for the provided shard id
+func (c *Conduit) GetShard(id int) (*Conn, bool) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	conn, ok := c.shards[id]
+	return conn, ok
+}
+
+// Start creates or resizes the conduit, opens a connection for every shard and assigns each session to its shard
+//
+// Shards are reassigned automatically after their connection is reopened or the API reports them as disconnected
+// until Close is called or the provided context is canceled. Calling Start again replaces the context of the
+// previous call.
+func (c *Conduit) Start(ctx context.Context) error {
+	c.start.Lock()
+	defer c.start.Unlock()
+
+	c.mx.Lock()
+	id, length := c.id, c.length
+	c.mx.Unlock()
+
+	var res *api.ConduitsResponse
+	var err error
+	if len(id) < 1 {
+		res, err = c.client.Conduits.Insert().ShardCount(length).Do(ctx, c.opts...)
+	} else {
+		res, err = c.client.Conduits.Update(id).ShardCount(length).Do(ctx, c.opts...)
+	}
+	if err != nil {
+		return err
+	}
+	if len(res.Conduits) < 1 {
+		return ErrNoConduit
+	}
+
+	c.mx.Lock()
+	c.id = res.Conduits[0].ID
+	if c.cancel != nil {
+		c.cancel()
+	}
+	c.mx.Unlock()
+	// The health check of a previous call must stop before a new one is started.
+	c.wg.Wait()
+
+	c.mx.Lock()
+	c.ctx, c.cancel = context.WithCancel(ctx)
+	if c.shards == nil {
+		c.shards = make(map[int]*Conn)
+	}
+	var removed []*Conn
+	for i, conn := range c.shards {
+		if i >= length {
+			removed = append(removed, conn)
+			delete(c.shards, i)
+		}
+	}
+	c.mx.Unlock()
+	for _, conn := range removed {
+		conn.Close()
+	}
+
+	for i := 0; i < length; i++ {
+		if _, err := c.connect(i); err != nil {
+			c.Close()
+			return err
+		}
+	}
+	if err := c.assign(ctx, c.allShards()...); err != nil {
+		c.Close()
+		return err
+	}
+
+	c.wg.Add(1)
+	go c.healthCheck()
+	return nil
+}
+
+// Close all shard connections and stop managing the conduit
+//
+// The conduit itself is not deleted.
+func (c *Conduit) Close() {
+	c.mx.Lock()
+	if c.cancel != nil {
+		c.cancel()
+	}
+	shards := c.shards
+	c.shards = nil
+	c.mx.Unlock()
+	for _, conn := range shards {
+		conn.Close()
+	}
+	c.wg.Wait()
+}
+
+// OnShardNotification event called after a shard receives an event for a subscription
+func (c *Conduit) OnShardNotification(f func(int, Notification)) {
+	c.onShardNotification = append(c.onShardNotification, f)
+}
+
+// OnShardRevocation event called after a subscription using the conduit has been revoked
+func (c *Conduit) OnShardRevocation(f func(int, Subscription)) {
+	c.onShardRevocation = append(c.onShardRevocation, f)
+}
+
+// OnShardAssign event called after a session has been assigned to a shard
+func (c *Conduit) OnShardAssign(f func(int, string)) {
+	c.onShardAssign = append(c.onShardAssign, f)
+}
+
+// OnShardReconnect event called after a shard connection is reopened
+func (c *Conduit) OnShardReconnect(f func(int)) {
+	c.onShardReconnect = append(c.onShardReconnect, f)
+}
+
+// OnShardDisconnect event called after a shard connection is closed
+func (c *Conduit) OnShardDisconnect(f func(int)) {
+	c.onShardDisconnect = append(c.onShardDisconnect, f)
+}
+
+// OnError event called when a shard could not be reconnected or reassigned
+func (c *Conduit) OnError(f func(error)) {
+	c.onError = append(c.onError, f)
+}
+
+// connect opens the connection for the provided shard id, creating it if needed.
+//
+// ErrConduitClosed is returned if the conduit has been closed.
+func (c *Conduit) connect(id int) (*Conn, error) {
+	c.mx.Lock()
+	if c.shards == nil || (c.ctx != nil && c.ctx.Err() != nil) {
+		c.mx.Unlock()
+		return nil, ErrConduitClosed
+	}
+	conn, ok := c.shards[id]
+	if !ok {
+		conn = &Conn{url: c.url}
+		c.addEventHandlers(id, conn)
+		c.shards[id] = conn
+	}
+	c.mx.Unlock()
+	if conn.IsConnected() {
+		return conn, nil
+	}
+	return conn, conn.Connect()
+}
+
+func (c *Conduit) addEventHandlers(id int, conn *Conn) {
+	conn.OnNotification(func(n Notification) {
+		for _, f := range c.onShardNotification {
+			go f(id, n)
+		}
+	})
+	conn.OnRevocation(func(s Subscription) {
+		for _, f := range c.onShardRevocation {
+			go f(id, s)
+		}
+	})
+	conn.OnReconnect(func() {
+		for _, f := range c.onShardReconnect {
+			go f(id)
+		}
+		if err := c.assign(c.context(), id); err != nil {
+			c.handleError(err)
+		}
+	})
+	conn.OnDisconnect(func() {
+		for _, f := range c.onShardDisconnect {
+			go f(id)
+		}
+		c.reconnect(id, conn)
+	})
+}
+
+// reconnect reopens a shard connection that was closed unexpectedly.
+func (c *Conduit) reconnect(id int, conn *Conn) {
+	ctx := c.context()
+	delay := time.Second
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+		if current, ok := c.GetShard(id); !ok || current != conn {
+			return
+		}
+		err := conn.reopen()
+		if err == nil || err == ErrAlreadyConnected || err == ErrNotConnected {
+			return
+		}
+		c.handleError(err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		if delay < time.Second*30 {
+			delay *= 2
+		}
+	}
+}
+
+// assign binds the sessions of the provided shard ids to the conduit.
+//
+// Shards rejected by the API are not reported as assigned and are returned in an AssignError.
+func (c *Conduit) assign(ctx context.Context, ids ...int) error {
+	if len(ids) < 1 {
+		return nil
+	}
+	conduitID := c.ID()
+	call := c.client.Conduits.Shards.Update(conduitID)
+	sessions := make(map[int]string)
+	for _, id := range ids {
+		conn, ok := c.GetShard(id)
+		if !ok || !conn.IsConnected() {
+			continue
+		}
+		sessions[id] = conn.SessionID()
+		call.Shard(strconv.Itoa(id), conn.Transport())
+	}
+	if len(sessions) < 1 {
+		return nil
+	}
+	res, err := call.Do(ctx, c.opts...)
+	if err != nil {
+		return err
+	}
+	for _, shardErr := range res.Errors {
+		if id, err := strconv.Atoi(shardErr.ID); err == nil {
+			delete(sessions, id)
+		}
+	}
+	for id, session := range sessions {
+		for _, f := range c.onShardAssign {
+			go f(id, session)
+		}
+	}
+	if len(res.Errors) > 0 {
+		return &AssignError{Shards: res.Errors}
+	}
+	return nil
+}
+
+// healthCheck periodically reassigns shards that the API no longer reports as enabled.
+func (c *Conduit) healthCheck() {
+	defer c.wg.Done()
+	ctx := c.context()
+	c.mx.Lock()
+	interval := c.interval
+	c.mx.Unlock()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.checkShards(ctx); err != nil && ctx.Err() == nil {
+				c.handleError(err)
+			}
+		}
+	}
+}
+
+func (c *Conduit) checkShards(ctx context.Context) error {
+	shards, err := c.client.Conduits.Shards.List(c.ID()).All(ctx, 0, c.opts...)
+	if err != nil {
+		return err
+	}
+	var unhealthy []int
+	for _, shard := range shards {
+		if shard.Status == "enabled" {
+			continue
+		}
+		id, err := strconv.Atoi(shard.ID)
+		if err != nil {
+			continue
+		}
+		if _, ok := c.GetShard(id); ok {
+			unhealthy = append(unhealthy, id)
+		}
+	}
+	for _, id := range unhealthy {
+		_, err := c.connect(id)
+		if err == ErrConduitClosed {
+			return err
+		}
+		if err != nil && err != ErrAlreadyConnected {
+			c.handleError(err)
+		}
+	}
+	return c.assign(ctx, unhealthy...)
+}
+
+func (c *Conduit) allShards() []int {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	ids := make([]int, 0, len(c.shards))
+	for id := range c.shards {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func (c *Conduit) context() context.Context {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
+}
+
+func (c *Conduit) handleError(err error) {
+	for _, f := range c.onError {
+		go f(err)
+	}
+}
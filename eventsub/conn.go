@@ -0,0 +1,358 @@
+package eventsub
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/adeithe/go-twitch/api"
+	"github.com/gorilla/websocket"
+)
+
+// Conn stores data about an EventSub WebSocket connection
+type Conn struct {
+	url     string
+	socket  *websocket.Conn
+	session Session
+	done    chan bool
+
+	isConnected bool
+	connecting  bool
+	closing     bool
+
+	onWelcome      []func(Session)
+	onNotification []func(Notification)
+	onRevocation   []func(Subscription)
+	onReconnect    []func()
+	onDisconnect   []func()
+
+	mx     sync.Mutex
+	writer sync.Mutex
+}
+
+// IConn interface for methods used by the EventSub connection
+type IConn interface {
+	SetURL(string) error
+	Connect() error
+	Reconnect() error
+	Close()
+
+	IsConnected() bool
+	Session() Session
+	SessionID() string
+	Transport() api.Transport
+
+	OnWelcome(func(Session))
+	OnNotification(func(Notification))
+	OnRevocation(func(Subscription))
+	OnReconnect(func())
+	OnDisconnect(func())
+}
+
+var _ IConn = &Conn{}
+
+// URL for the EventSub WebSocket server
+const URL = "wss://eventsub.wss.twitch.tv/ws"
+
+// welcomeTimeout is how long the server has to send a welcome message after connecting
+const welcomeTimeout = time.Second * 10
+
+// keepaliveGrace is added to the keepalive timeout of a session to allow for latency
+const keepaliveGrace = time.Second
+
+// SetURL changes the URL the connection will be opened to
+//
+// The keepalive timeout may be changed by providing the keepalive_timeout_seconds query parameter.
+// Will return an error if the connection is already open
+func (conn *Conn) SetURL(url string) error {
+	conn.mx.Lock()
+	defer conn.mx.Unlock()
+	if conn.isConnected {
+		return ErrAlreadyConnected
+	}
+	conn.url = url
+	return nil
+}
+
+// Connect to the EventSub server
+//
+// This operation will block until the server has sent the welcome message for the new session.
+// Will return ErrNotConnected once the connection has been closed with Close. Use Reconnect to open it again.
+func (conn *Conn) Connect() error {
+	conn.mx.Lock()
+	if conn.closing {
+		conn.mx.Unlock()
+		return ErrNotConnected
+	}
+	if conn.isConnected || conn.connecting {
+		conn.mx.Unlock()
+		return ErrAlreadyConnected
+	}
+	// The connection is reserved so that concurrent calls do not open more than one socket.
+	conn.connecting = true
+	url := conn.url
+	conn.mx.Unlock()
+	if len(url) < 1 {
+		url = URL
+	}
+	socket, session, err := dial(url)
+	conn.mx.Lock()
+	conn.connecting = false
+	if err != nil {
+		conn.mx.Unlock()
+		return err
+	}
+	if conn.closing {
+		// Close was called while the socket was being opened.
+		conn.mx.Unlock()
+		socket.Close()
+		return ErrNotConnected
+	}
+	conn.socket = socket
+	conn.session = session
+	conn.done = make(chan bool)
+	conn.isConnected = true
+	done := conn.done
+	conn.mx.Unlock()
+	go conn.reader(socket, done)
+	for _, f := range conn.onWelcome {
+		go f(session)
+	}
+	return nil
+}
+
+// Reconnect closes the connection and opens a new session on the EventSub server
+//
+// Subscriptions made for the previous session will not carry over to the new session.
+// This also opens a connection that was closed with Close.
+func (conn *Conn) Reconnect() error {
+	if conn.IsConnected() {
+		conn.Close()
+	}
+	conn.mx.Lock()
+	conn.closing = false
+	conn.mx.Unlock()
+	return conn.reopen()
+}
+
+// reopen opens the connection after it was lost and calls the reconnect handlers.
+//
+// Unlike Reconnect, a connection that was closed with Close stays closed.
+func (conn *Conn) reopen() error {
+	if err := conn.Connect(); err != nil {
+		return err
+	}
+	for _, f := range conn.onReconnect {
+		go f()
+	}
+	return nil
+}
+
+// Close the connection to the EventSub server
+//
+// The connection stays closed until Reconnect is called.
+func (conn *Conn) Close() {
+	conn.mx.Lock()
+	// The session can no longer be moved to a new socket once closing, so the current socket is the last one.
+	conn.closing = true
+	if !conn.isConnected {
+		conn.mx.Unlock()
+		return
+	}
+	socket, done := conn.socket, conn.done
+	conn.mx.Unlock()
+	conn.writer.Lock()
+	socket.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	conn.writer.Unlock()
+	timer := time.NewTimer(time.Second)
+	defer timer.Stop()
+	select {
+	case <-done:
+	case <-timer.C:
+		socket.Close()
+		<-done
+	}
+}
+
+// IsConnected returns true if the socket is actively connected
+func (conn *Conn) IsConnected() bool {
+	conn.mx.Lock()
+	defer conn.mx.Unlock()
+	return conn.isConnected
+}
+
+// Session returns the most recent session sent by the server
+func (conn *Conn) Session() Session {
+	conn.mx.Lock()
+	defer conn.mx.Unlock()
+	return conn.session
+}
+
+// SessionID returns the ID of the current session
+//
+// Subscriptions created for this connection must use this ID in their transport.
+func (conn *Conn) SessionID() string {
+	return conn.Session().ID
+}
+
+// Transport returns a websocket transport for the current session
+func (conn *Conn) Transport() api.Transport {
+	return api.NewWebSocketTransport(conn.SessionID())
+}
+
+// OnWelcome event called after the server starts a new session
+func (conn *Conn) OnWelcome(f func(Session)) {
+	conn.onWelcome = append(conn.onWelcome, f)
+}
+
+// OnNotification event called after an event is received for a subscription
+func (conn *Conn) OnNotification(f func(Notification)) {
+	conn.onNotification = append(conn.onNotification, f)
+}
+
+// OnRevocation event called after a subscription has been revoked by the server
+func (conn *Conn) OnRevocation(f func(Subscription)) {
+	conn.onRevocation = append(conn.onRevocation, f)
+}
+
+// OnReconnect event called after the connection is reopened
+//
+// This is also called after the server has moved the session to a new connection.
+func (conn *Conn) OnReconnect(f func()) {
+	conn.onReconnect = append(conn.onReconnect, f)
+}
+
+// OnDisconnect event called after the connection is closed
+func (conn *Conn) OnDisconnect(f func()) {
+	conn.onDisconnect = append(conn.onDisconnect, f)
+}
+
+func (conn *Conn) reader(socket *websocket.Conn, done chan bool) {
+	for {
+		timeout := time.Duration(conn.Session().KeepaliveTimeoutSeconds) * time.Second
+		if timeout > 0 {
+			socket.SetReadDeadline(time.Now().Add(timeout + keepaliveGrace))
+		}
+		msgType, bytes, err := socket.ReadMessage()
+		if err != nil || msgType == websocket.CloseMessage {
+			break
+		}
+		var msg Packet
+		if err := json.Unmarshal(bytes, &msg); err != nil {
+			continue
+		}
+		switch msg.Metadata.Type {
+		case KeepaliveMessage:
+		case NotificationMessage:
+			conn.handleNotification(msg)
+		case RevocationMessage:
+			conn.handleRevocation(msg)
+		case ReconnectMessage:
+			conn.handleReconnect(socket, msg)
+		}
+	}
+	socket.Close()
+	conn.mx.Lock()
+	if conn.socket != socket {
+		// The session has been moved to a new connection.
+		conn.mx.Unlock()
+		return
+	}
+	conn.isConnected = false
+	close(done)
+	conn.mx.Unlock()
+	for _, f := range conn.onDisconnect {
+		go f()
+	}
+}
+
+func (conn *Conn) handleNotification(msg Packet) {
+	var notification Notification
+	if err := json.Unmarshal(msg.Payload, &notification); err != nil {
+		return
+	}
+	notification.Metadata = msg.Metadata
+	for _, f := range conn.onNotification {
+		go f(notification)
+	}
+}
+
+func (conn *Conn) handleRevocation(msg Packet) {
+	var payload struct {
+		Subscription Subscription `json:"subscription"`
+	}
+	if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+		return
+	}
+	for _, f := range conn.onRevocation {
+		go f(payload.Subscription)
+	}
+}
+
+// handleReconnect moves the session to the reconnect URL provided by the server.
+//
+// Once the new socket has received its welcome message, the old socket is asked to close so that any messages the
+// server sent on it before then are still read. The new socket is dropped if the connection is closed while it
+// is being opened.
+func (conn *Conn) handleReconnect(old *websocket.Conn, msg Packet) {
+	var payload struct {
+		Session Session `json:"session"`
+	}
+	if err := json.Unmarshal(msg.Payload, &payload); err != nil || len(payload.Session.ReconnectURL) < 1 {
+		return
+	}
+	socket, session, err := dial(payload.Session.ReconnectURL)
+	if err != nil {
+		return
+	}
+	conn.mx.Lock()
+	if conn.socket != old || conn.closing {
+		conn.mx.Unlock()
+		socket.Close()
+		return
+	}
+	conn.socket = socket
+	conn.session = session
+	done := conn.done
+	conn.mx.Unlock()
+	go conn.reader(socket, done)
+	for _, f := range conn.onReconnect {
+		go f()
+	}
+
+	conn.writer.Lock()
+	old.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	conn.writer.Unlock()
+	// The server may never answer the close message, so the socket is closed regardless after a short wait.
+	time.AfterFunc(time.Second, func() {
+		old.Close()
+	})
+}
+
+// dial opens a socket to the provided URL and waits for the welcome message.
+func dial(url string) (*websocket.Conn, Session, error) {
+	socket, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		return nil, Session{}, err
+	}
+	socket.SetReadDeadline(time.Now().Add(welcomeTimeout))
+	_, bytes, err := socket.ReadMessage()
+	if err != nil {
+		socket.Close()
+		return nil, Session{}, err
+	}
+	var msg Packet
+	if err := json.Unmarshal(bytes, &msg); err != nil || msg.Metadata.Type != WelcomeMessage {
+		socket.Close()
+		return nil, Session{}, ErrNoWelcome
+	}
+	var payload struct {
+		Session Session `json:"session"`
+	}
+	if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+		socket.Close()
+		return nil, Session{}, err
+	}
+	socket.SetReadDeadline(time.Time{})
+	return socket, payload.Session, nil
+}
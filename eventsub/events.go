@@ -0,0 +1,736 @@
+package eventsub
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ChannelUpdateEvent is sent for channel.update subscriptions
+type ChannelUpdateEvent struct {
+	BroadcasterUserID           string   `json:"broadcaster_user_id"`
+	BroadcasterUserLogin        string   `json:"broadcaster_user_login"`
+	BroadcasterUserName         string   `json:"broadcaster_user_name"`
+	Title                       string   `json:"title"`
+	Language                    string   `json:"language"`
+	CategoryID                  string   `json:"category_id"`
+	CategoryName                string   `json:"category_name"`
+	ContentClassificationLabels []string `json:"content_classification_labels"`
+}
+
+// ChannelFollowEvent is sent for channel.follow subscriptions
+type ChannelFollowEvent struct {
+	UserID               string    `json:"user_id"`
+	UserLogin            string    `json:"user_login"`
+	UserName             string    `json:"user_name"`
+	BroadcasterUserID    string    `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string    `json:"broadcaster_user_login"`
+	BroadcasterUserName  string    `json:"broadcaster_user_name"`
+	FollowedAt           time.Time `json:"followed_at"`
+}
+
+// ChannelAdBreakBeginEvent is sent for channel.ad_break.begin subscriptions
+type ChannelAdBreakBeginEvent struct {
+	BroadcasterUserID    string    `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string    `json:"broadcaster_user_login"`
+	BroadcasterUserName  string    `json:"broadcaster_user_name"`
+	RequesterUserID      string    `json:"requester_user_id"`
+	RequesterUserLogin   string    `json:"requester_user_login"`
+	RequesterUserName    string    `json:"requester_user_name"`
+	DurationSeconds      int       `json:"duration_seconds"`
+	IsAutomatic          bool      `json:"is_automatic"`
+	StartedAt            time.Time `json:"started_at"`
+}
+
+// ChannelChatClearEvent is sent for channel.chat.clear subscriptions
+type ChannelChatClearEvent struct {
+	BroadcasterUserID    string `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string `json:"broadcaster_user_login"`
+	BroadcasterUserName  string `json:"broadcaster_user_name"`
+}
+
+// ChannelChatClearUserMessagesEvent is sent for channel.chat.clear_user_messages subscriptions
+type ChannelChatClearUserMessagesEvent struct {
+	BroadcasterUserID    string `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string `json:"broadcaster_user_login"`
+	BroadcasterUserName  string `json:"broadcaster_user_name"`
+	TargetUserID         string `json:"target_user_id"`
+	TargetUserLogin      string `json:"target_user_login"`
+	TargetUserName       string `json:"target_user_name"`
+}
+
+// ChatBadge is a badge shown next to a users name in chat
+type ChatBadge struct {
+	SetID string `json:"set_id"`
+	ID    string `json:"id"`
+	Info  string `json:"info"`
+}
+
+// ChatMessage is the text of a chat message split into fragments
+type ChatMessage struct {
+	Text      string         `json:"text"`
+	Fragments []ChatFragment `json:"fragments"`
+}
+
+// ChatFragment is a part of a chat message
+//
+// Type is one of "text", "cheermote", "emote" or "mention" and only the matching field will be set.
+type ChatFragment struct {
+	Type      string `json:"type"`
+	Text      string `json:"text"`
+	Cheermote *struct {
+		Prefix string `json:"prefix"`
+		Bits   int    `json:"bits"`
+		Tier   int    `json:"tier"`
+	} `json:"cheermote,omitempty"`
+	Emote *struct {
+		ID         string   `json:"id"`
+		EmoteSetID string   `json:"emote_set_id"`
+		OwnerID    string   `json:"owner_id"`
+		Format     []string `json:"format"`
+	} `json:"emote,omitempty"`
+	Mention *struct {
+		UserID    string `json:"user_id"`
+		UserLogin string `json:"user_login"`
+		UserName  string `json:"user_name"`
+	} `json:"mention,omitempty"`
+}
+
+// ChatMessageReply is the message a chat message is replying to
+type ChatMessageReply struct {
+	ParentMessageID   string `json:"parent_message_id"`
+	ParentMessageBody string `json:"parent_message_body"`
+	ParentUserID      string `json:"parent_user_id"`
+	ParentUserLogin   string `json:"parent_user_login"`
+	ParentUserName    string `json:"parent_user_name"`
+	ThreadMessageID   string `json:"thread_message_id"`
+	ThreadUserID      string `json:"thread_user_id"`
+	ThreadUserLogin   string `json:"thread_user_login"`
+	ThreadUserName    string `json:"thread_user_name"`
+}
+
+// ChannelChatMessageEvent is sent for channel.chat.message subscriptions
+type ChannelChatMessageEvent struct {
+	BroadcasterUserID           string            `json:"broadcaster_user_id"`
+	BroadcasterUserLogin        string            `json:"broadcaster_user_login"`
+	BroadcasterUserName         string            `json:"broadcaster_user_name"`
+	ChatterUserID               string            `json:"chatter_user_id"`
+	ChatterUserLogin            string            `json:"chatter_user_login"`
+	ChatterUserName             string            `json:"chatter_user_name"`
+	MessageID                   string            `json:"message_id"`
+	Message                     ChatMessage       `json:"message"`
+	MessageType                 string            `json:"message_type"`
+	Color                       string            `json:"color"`
+	Badges                      []ChatBadge       `json:"badges"`
+	Reply                       *ChatMessageReply `json:"reply,omitempty"`
+	ChannelPointsCustomRewardID string            `json:"channel_points_custom_reward_id"`
+	Cheer                       *struct {
+		Bits int `json:"bits"`
+	} `json:"cheer,omitempty"`
+}
+
+// ChannelChatMessageDeleteEvent is sent for channel.chat.message_delete subscriptions
+type ChannelChatMessageDeleteEvent struct {
+	BroadcasterUserID    string `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string `json:"broadcaster_user_login"`
+	BroadcasterUserName  string `json:"broadcaster_user_name"`
+	TargetUserID         string `json:"target_user_id"`
+	TargetUserLogin      string `json:"target_user_login"`
+	TargetUserName       string `json:"target_user_name"`
+	MessageID            string `json:"message_id"`
+}
+
+// ChannelChatNotificationEvent is sent for channel.chat.notification subscriptions
+//
+// NoticeType describes which of the optional fields is set.
+type ChannelChatNotificationEvent struct {
+	BroadcasterUserID    string      `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string      `json:"broadcaster_user_login"`
+	BroadcasterUserName  string      `json:"broadcaster_user_name"`
+	ChatterUserID        string      `json:"chatter_user_id"`
+	ChatterUserLogin     string      `json:"chatter_user_login"`
+	ChatterUserName      string      `json:"chatter_user_name"`
+	ChatterIsAnonymous   bool        `json:"chatter_is_anonymous"`
+	Color                string      `json:"color"`
+	Badges               []ChatBadge `json:"badges"`
+	SystemMessage        string      `json:"system_message"`
+	MessageID            string      `json:"message_id"`
+	Message              ChatMessage `json:"message"`
+	NoticeType           string      `json:"notice_type"`
+	Sub                  *struct {
+		SubTier        string `json:"sub_tier"`
+		IsPrime        bool   `json:"is_prime"`
+		DurationMonths int    `json:"duration_months"`
+	} `json:"sub,omitempty"`
+	Resub *struct {
+		CumulativeMonths  int    `json:"cumulative_months"`
+		DurationMonths    int    `json:"duration_months"`
+		StreakMonths      int    `json:"streak_months"`
+		SubTier           string `json:"sub_tier"`
+		IsPrime           bool   `json:"is_prime"`
+		IsGift            bool   `json:"is_gift"`
+		GifterIsAnonymous bool   `json:"gifter_is_anonymous"`
+		GifterUserID      string `json:"gifter_user_id"`
+		GifterUserLogin   string `json:"gifter_user_login"`
+		GifterUserName    string `json:"gifter_user_name"`
+	} `json:"resub,omitempty"`
+	SubGift *struct {
+		DurationMonths     int    `json:"duration_months"`
+		CumulativeTotal    int    `json:"cumulative_total"`
+		RecipientUserID    string `json:"recipient_user_id"`
+		RecipientUserLogin string `json:"recipient_user_login"`
+		RecipientUserName  string `json:"recipient_user_name"`
+		SubTier            string `json:"sub_tier"`
+		CommunityGiftID    string `json:"community_gift_id"`
+	} `json:"sub_gift,omitempty"`
+	CommunitySubGift *struct {
+		ID              string `json:"id"`
+		Total           int    `json:"total"`
+		SubTier         string `json:"sub_tier"`
+		CumulativeTotal int    `json:"cumulative_total"`
+	} `json:"community_sub_gift,omitempty"`
+	Raid *struct {
+		UserID          string `json:"user_id"`
+		UserLogin       string `json:"user_login"`
+		UserName        string `json:"user_name"`
+		ViewerCount     int    `json:"viewer_count"`
+		ProfileImageURL string `json:"profile_image_url"`
+	} `json:"raid,omitempty"`
+	Announcement *struct {
+		Color string `json:"color"`
+	} `json:"announcement,omitempty"`
+	BitsBadgeTier *struct {
+		Tier int `json:"tier"`
+	} `json:"bits_badge_tier,omitempty"`
+}
+
+// ChannelChatSettingsUpdateEvent is sent for channel.chat_settings.update subscriptions
+type ChannelChatSettingsUpdateEvent struct {
+	BroadcasterUserID           string `json:"broadcaster_user_id"`
+	BroadcasterUserLogin        string `json:"broadcaster_user_login"`
+	BroadcasterUserName         string `json:"broadcaster_user_name"`
+	EmoteMode                   bool   `json:"emote_mode"`
+	FollowerMode                bool   `json:"follower_mode"`
+	FollowerModeDurationMinutes *int   `json:"follower_mode_duration_minutes"`
+	SlowMode                    bool   `json:"slow_mode"`
+	SlowModeWaitTimeSeconds     *int   `json:"slow_mode_wait_time_seconds"`
+	SubscriberMode              bool   `json:"subscriber_mode"`
+	UniqueChatMode              bool   `json:"unique_chat_mode"`
+}
+
+// ChannelSubscribeEvent is sent for channel.subscribe and channel.subscription.end subscriptions
+type ChannelSubscribeEvent struct {
+	UserID               string `json:"user_id"`
+	UserLogin            string `json:"user_login"`
+	UserName             string `json:"user_name"`
+	BroadcasterUserID    string `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string `json:"broadcaster_user_login"`
+	BroadcasterUserName  string `json:"broadcaster_user_name"`
+	Tier                 string `json:"tier"`
+	IsGift               bool   `json:"is_gift"`
+}
+
+// ChannelSubscriptionGiftEvent is sent for channel.subscription.gift subscriptions
+//
+// The user fields will be empty if the gift was anonymous.
+type ChannelSubscriptionGiftEvent struct {
+	UserID               string `json:"user_id"`
+	UserLogin            string `json:"user_login"`
+	UserName             string `json:"user_name"`
+	BroadcasterUserID    string `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string `json:"broadcaster_user_login"`
+	BroadcasterUserName  string `json:"broadcaster_user_name"`
+	Total                int    `json:"total"`
+	Tier                 string `json:"tier"`
+	CumulativeTotal      *int   `json:"cumulative_total"`
+	IsAnonymous          bool   `json:"is_anonymous"`
+}
+
+// ChannelSubscriptionMessageEvent is sent for channel.subscription.message subscriptions
+type ChannelSubscriptionMessageEvent struct {
+	UserID               string `json:"user_id"`
+	UserLogin            string `json:"user_login"`
+	UserName             string `json:"user_name"`
+	BroadcasterUserID    string `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string `json:"broadcaster_user_login"`
+	BroadcasterUserName  string `json:"broadcaster_user_name"`
+	Tier                 string `json:"tier"`
+	Message              struct {
+		Text   string `json:"text"`
+		Emotes []struct {
+			Begin int    `json:"begin"`
+			End   int    `json:"end"`
+			ID    string `json:"id"`
+		} `json:"emotes"`
+	} `json:"message"`
+	CumulativeMonths int  `json:"cumulative_months"`
+	StreakMonths     *int `json:"streak_months"`
+	DurationMonths   int  `json:"duration_months"`
+}
+
+// ChannelCheerEvent is sent for channel.cheer subscriptions
+//
+// The user fields will be empty if the cheer was anonymous.
+type ChannelCheerEvent struct {
+	IsAnonymous          bool   `json:"is_anonymous"`
+	UserID               string `json:"user_id"`
+	UserLogin            string `json:"user_login"`
+	UserName             string `json:"user_name"`
+	BroadcasterUserID    string `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string `json:"broadcaster_user_login"`
+	BroadcasterUserName  string `json:"broadcaster_user_name"`
+	Message              string `json:"message"`
+	Bits                 int    `json:"bits"`
+}
+
+// ChannelRaidEvent is sent for channel.raid subscriptions
+type ChannelRaidEvent struct {
+	FromBroadcasterUserID    string `json:"from_broadcaster_user_id"`
+	FromBroadcasterUserLogin string `json:"from_broadcaster_user_login"`
+	FromBroadcasterUserName  string `json:"from_broadcaster_user_name"`
+	ToBroadcasterUserID      string `json:"to_broadcaster_user_id"`
+	ToBroadcasterUserLogin   string `json:"to_broadcaster_user_login"`
+	ToBroadcasterUserName    string `json:"to_broadcaster_user_name"`
+	Viewers                  int    `json:"viewers"`
+}
+
+// ChannelBanEvent is sent for channel.ban subscriptions
+type ChannelBanEvent struct {
+	UserID               string     `json:"user_id"`
+	UserLogin            string     `json:"user_login"`
+	UserName             string     `json:"user_name"`
+	BroadcasterUserID    string     `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string     `json:"broadcaster_user_login"`
+	BroadcasterUserName  string     `json:"broadcaster_user_name"`
+	ModeratorUserID      string     `json:"moderator_user_id"`
+	ModeratorUserLogin   string     `json:"moderator_user_login"`
+	ModeratorUserName    string     `json:"moderator_user_name"`
+	Reason               string     `json:"reason"`
+	BannedAt             time.Time  `json:"banned_at"`
+	EndsAt               *time.Time `json:"ends_at"`
+	IsPermanent          bool       `json:"is_permanent"`
+}
+
+// ChannelUnbanEvent is sent for channel.unban subscriptions
+type ChannelUnbanEvent struct {
+	UserID               string `json:"user_id"`
+	UserLogin            string `json:"user_login"`
+	UserName             string `json:"user_name"`
+	BroadcasterUserID    string `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string `json:"broadcaster_user_login"`
+	BroadcasterUserName  string `json:"broadcaster_user_name"`
+	ModeratorUserID      string `json:"moderator_user_id"`
+	ModeratorUserLogin   string `json:"moderator_user_login"`
+	ModeratorUserName    string `json:"moderator_user_name"`
+}
+
+// ChannelRoleEvent is sent for channel.moderator.add, channel.moderator.remove, channel.vip.add and channel.vip.remove subscriptions
+type ChannelRoleEvent struct {
+	UserID               string `json:"user_id"`
+	UserLogin            string `json:"user_login"`
+	UserName             string `json:"user_name"`
+	BroadcasterUserID    string `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string `json:"broadcaster_user_login"`
+	BroadcasterUserName  string `json:"broadcaster_user_name"`
+}
+
+// CustomRewardImage stores the URLs for each size of a custom reward image
+type CustomRewardImage struct {
+	URL1x string `json:"url_1x"`
+	URL2x string `json:"url_2x"`
+	URL4x string `json:"url_4x"`
+}
+
+// ChannelPointsCustomRewardEvent is sent for channel.channel_points_custom_reward.add, channel.channel_points_custom_reward.update
+// and channel.channel_points_custom_reward.remove subscriptions
+type ChannelPointsCustomRewardEvent struct {
+	ID                                string `json:"id"`
+	BroadcasterUserID                 string `json:"broadcaster_user_id"`
+	BroadcasterUserLogin              string `json:"broadcaster_user_login"`
+	BroadcasterUserName               string `json:"broadcaster_user_name"`
+	IsEnabled                         bool   `json:"is_enabled"`
+	IsPaused                          bool   `json:"is_paused"`
+	IsInStock                         bool   `json:"is_in_stock"`
+	Title                             string `json:"title"`
+	Cost                              int64  `json:"cost"`
+	Prompt                            string `json:"prompt"`
+	IsUserInputRequired               bool   `json:"is_user_input_required"`
+	ShouldRedemptionsSkipRequestQueue bool   `json:"should_redemptions_skip_request_queue"`
+	MaxPerStream                      struct {
+		IsEnabled bool  `json:"is_enabled"`
+		Value     int64 `json:"value"`
+	} `json:"max_per_stream"`
+	MaxPerUserPerStream struct {
+		IsEnabled bool  `json:"is_enabled"`
+		Value     int64 `json:"value"`
+	} `json:"max_per_user_per_stream"`
+	BackgroundColor string             `json:"background_color"`
+	Image           *CustomRewardImage `json:"image"`
+	DefaultImage    CustomRewardImage  `json:"default_image"`
+	GlobalCooldown  struct {
+		IsEnabled bool  `json:"is_enabled"`
+		Seconds   int64 `json:"seconds"`
+	} `json:"global_cooldown"`
+	CooldownExpiresAt                *time.Time `json:"cooldown_expires_at"`
+	RedemptionsRedeemedCurrentStream *int64     `json:"redemptions_redeemed_current_stream"`
+}
+
+// ChannelPointsCustomRewardRedemptionEvent is sent for channel.channel_points_custom_reward_redemption.add
+// and channel.channel_points_custom_reward_redemption.update subscriptions
+type ChannelPointsCustomRewardRedemptionEvent struct {
+	ID                   string `json:"id"`
+	BroadcasterUserID    string `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string `json:"broadcaster_user_login"`
+	BroadcasterUserName  string `json:"broadcaster_user_name"`
+	UserID               string `json:"user_id"`
+	UserLogin            string `json:"user_login"`
+	UserName             string `json:"user_name"`
+	UserInput            string `json:"user_input"`
+	Status               string `json:"status"`
+	Reward               struct {
+		ID     string `json:"id"`
+		Title  string `json:"title"`
+		Cost   int64  `json:"cost"`
+		Prompt string `json:"prompt"`
+	} `json:"reward"`
+	RedeemedAt time.Time `json:"redeemed_at"`
+}
+
+// PollChoice is a choice that can be voted for in a poll
+type PollChoice struct {
+	ID                 string `json:"id"`
+	Title              string `json:"title"`
+	BitsVotes          int    `json:"bits_votes"`
+	ChannelPointsVotes int    `json:"channel_points_votes"`
+	Votes              int    `json:"votes"`
+}
+
+// PollVoting stores whether extra votes can be purchased in a poll
+type PollVoting struct {
+	IsEnabled     bool `json:"is_enabled"`
+	AmountPerVote int  `json:"amount_per_vote"`
+}
+
+// ChannelPollEvent is sent for channel.poll.begin, channel.poll.progress and channel.poll.end subscriptions
+//
+// Status and EndedAt are only set for channel.poll.end. EndsAt is not set for channel.poll.end.
+type ChannelPollEvent struct {
+	ID                   string       `json:"id"`
+	BroadcasterUserID    string       `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string       `json:"broadcaster_user_login"`
+	BroadcasterUserName  string       `json:"broadcaster_user_name"`
+	Title                string       `json:"title"`
+	Choices              []PollChoice `json:"choices"`
+	BitsVoting           PollVoting   `json:"bits_voting"`
+	ChannelPointsVoting  PollVoting   `json:"channel_points_voting"`
+	Status               string       `json:"status,omitempty"`
+	StartedAt            time.Time    `json:"started_at"`
+	EndsAt               *time.Time   `json:"ends_at,omitempty"`
+	EndedAt              *time.Time   `json:"ended_at,omitempty"`
+}
+
+// PredictionOutcome is an outcome that can be predicted
+type PredictionOutcome struct {
+	ID            string `json:"id"`
+	Title         string `json:"title"`
+	Color         string `json:"color"`
+	Users         int    `json:"users"`
+	ChannelPoints int64  `json:"channel_points"`
+	TopPredictors []struct {
+		UserID            string `json:"user_id"`
+		UserLogin         string `json:"user_login"`
+		UserName          string `json:"user_name"`
+		ChannelPointsWon  *int64 `json:"channel_points_won"`
+		ChannelPointsUsed int64  `json:"channel_points_used"`
+	} `json:"top_predictors"`
+}
+
+// ChannelPredictionEvent is sent for channel.prediction.begin, channel.prediction.progress, channel.prediction.lock
+// and channel.prediction.end subscriptions
+type ChannelPredictionEvent struct {
+	ID                   string              `json:"id"`
+	BroadcasterUserID    string              `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string              `json:"broadcaster_user_login"`
+	BroadcasterUserName  string              `json:"broadcaster_user_name"`
+	Title                string              `json:"title"`
+	WinningOutcomeID     string              `json:"winning_outcome_id,omitempty"`
+	Outcomes             []PredictionOutcome `json:"outcomes"`
+	Status               string              `json:"status,omitempty"`
+	StartedAt            time.Time           `json:"started_at"`
+	LocksAt              *time.Time          `json:"locks_at,omitempty"`
+	LockedAt             *time.Time          `json:"locked_at,omitempty"`
+	EndedAt              *time.Time          `json:"ended_at,omitempty"`
+}
+
+// HypeTrainContribution is a contribution made towards a hype train
+type HypeTrainContribution struct {
+	UserID    string `json:"user_id"`
+	UserLogin string `json:"user_login"`
+	UserName  string `json:"user_name"`
+	Type      string `json:"type"`
+	Total     int    `json:"total"`
+}
+
+// ChannelHypeTrainEvent is sent for channel.hype_train.begin, channel.hype_train.progress and channel.hype_train.end subscriptions
+type ChannelHypeTrainEvent struct {
+	ID                   string                  `json:"id"`
+	BroadcasterUserID    string                  `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string                  `json:"broadcaster_user_login"`
+	BroadcasterUserName  string                  `json:"broadcaster_user_name"`
+	Level                int                     `json:"level"`
+	Total                int                     `json:"total"`
+	Progress             int                     `json:"progress"`
+	Goal                 int                     `json:"goal"`
+	TopContributions     []HypeTrainContribution `json:"top_contributions"`
+	LastContribution     *HypeTrainContribution  `json:"last_contribution,omitempty"`
+	StartedAt            time.Time               `json:"started_at"`
+	ExpiresAt            *time.Time              `json:"expires_at,omitempty"`
+	EndedAt              *time.Time              `json:"ended_at,omitempty"`
+	CooldownEndsAt       *time.Time              `json:"cooldown_ends_at,omitempty"`
+}
+
+// ChannelGoalEvent is sent for channel.goal.begin, channel.goal.progress and channel.goal.end subscriptions
+type ChannelGoalEvent struct {
+	ID                   string     `json:"id"`
+	BroadcasterUserID    string     `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string     `json:"broadcaster_user_login"`
+	BroadcasterUserName  string     `json:"broadcaster_user_name"`
+	Type                 string     `json:"type"`
+	Description          string     `json:"description"`
+	IsAchieved           bool       `json:"is_achieved"`
+	CurrentAmount        int        `json:"current_amount"`
+	TargetAmount         int        `json:"target_amount"`
+	StartedAt            time.Time  `json:"started_at"`
+	EndedAt              *time.Time `json:"ended_at,omitempty"`
+}
+
+// CharityAmount is an amount of money donated to or raised for a charity
+type CharityAmount struct {
+	Value         int64  `json:"value"`
+	DecimalPlaces int    `json:"decimal_places"`
+	Currency      string `json:"currency"`
+}
+
+// ChannelCharityDonationEvent is sent for channel.charity_campaign.donate subscriptions
+type ChannelCharityDonationEvent struct {
+	ID                   string        `json:"id"`
+	CampaignID           string        `json:"campaign_id"`
+	BroadcasterUserID    string        `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string        `json:"broadcaster_user_login"`
+	BroadcasterUserName  string        `json:"broadcaster_user_name"`
+	UserID               string        `json:"user_id"`
+	UserLogin            string        `json:"user_login"`
+	UserName             string        `json:"user_name"`
+	CharityName          string        `json:"charity_name"`
+	CharityDescription   string        `json:"charity_description"`
+	CharityLogo          string        `json:"charity_logo"`
+	CharityWebsite       string        `json:"charity_website"`
+	Amount               CharityAmount `json:"amount"`
+}
+
+// ChannelCharityCampaignEvent is sent for channel.charity_campaign.start, channel.charity_campaign.progress
+// and channel.charity_campaign.stop subscriptions
+type ChannelCharityCampaignEvent struct {
+	ID                   string        `json:"id"`
+	BroadcasterUserID    string        `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string        `json:"broadcaster_user_login"`
+	BroadcasterUserName  string        `json:"broadcaster_user_name"`
+	CharityName          string        `json:"charity_name"`
+	CharityDescription   string        `json:"charity_description"`
+	CharityLogo          string        `json:"charity_logo"`
+	CharityWebsite       string        `json:"charity_website"`
+	CurrentAmount        CharityAmount `json:"current_amount"`
+	TargetAmount         CharityAmount `json:"target_amount"`
+	StartedAt            *time.Time    `json:"started_at,omitempty"`
+	StoppedAt            *time.Time    `json:"stopped_at,omitempty"`
+}
+
+// ChannelShieldModeEvent is sent for channel.shield_mode.begin and channel.shield_mode.end subscriptions
+type ChannelShieldModeEvent struct {
+	BroadcasterUserID    string     `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string     `json:"broadcaster_user_login"`
+	BroadcasterUserName  string     `json:"broadcaster_user_name"`
+	ModeratorUserID      string     `json:"moderator_user_id"`
+	ModeratorUserLogin   string     `json:"moderator_user_login"`
+	ModeratorUserName    string     `json:"moderator_user_name"`
+	StartedAt            *time.Time `json:"started_at,omitempty"`
+	EndedAt              *time.Time `json:"ended_at,omitempty"`
+}
+
+// ChannelShoutoutCreateEvent is sent for channel.shoutout.create subscriptions
+type ChannelShoutoutCreateEvent struct {
+	BroadcasterUserID      string    `json:"broadcaster_user_id"`
+	BroadcasterUserLogin   string    `json:"broadcaster_user_login"`
+	BroadcasterUserName    string    `json:"broadcaster_user_name"`
+	ToBroadcasterUserID    string    `json:"to_broadcaster_user_id"`
+	ToBroadcasterUserLogin string    `json:"to_broadcaster_user_login"`
+	ToBroadcasterUserName  string    `json:"to_broadcaster_user_name"`
+	ModeratorUserID        string    `json:"moderator_user_id"`
+	ModeratorUserLogin     string    `json:"moderator_user_login"`
+	ModeratorUserName      string    `json:"moderator_user_name"`
+	ViewerCount            int       `json:"viewer_count"`
+	StartedAt              time.Time `json:"started_at"`
+	CooldownEndsAt         time.Time `json:"cooldown_ends_at"`
+	TargetCooldownEndsAt   time.Time `json:"target_cooldown_ends_at"`
+}
+
+// ChannelShoutoutReceiveEvent is sent for channel.shoutout.receive subscriptions
+type ChannelShoutoutReceiveEvent struct {
+	BroadcasterUserID        string    `json:"broadcaster_user_id"`
+	BroadcasterUserLogin     string    `json:"broadcaster_user_login"`
+	BroadcasterUserName      string    `json:"broadcaster_user_name"`
+	FromBroadcasterUserID    string    `json:"from_broadcaster_user_id"`
+	FromBroadcasterUserLogin string    `json:"from_broadcaster_user_login"`
+	FromBroadcasterUserName  string    `json:"from_broadcaster_user_name"`
+	ViewerCount              int       `json:"viewer_count"`
+	StartedAt                time.Time `json:"started_at"`
+}
+
+// StreamOnlineEvent is sent for stream.online subscriptions
+type StreamOnlineEvent struct {
+	ID                   string    `json:"id"`
+	BroadcasterUserID    string    `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string    `json:"broadcaster_user_login"`
+	BroadcasterUserName  string    `json:"broadcaster_user_name"`
+	Type                 string    `json:"type"`
+	StartedAt            time.Time `json:"started_at"`
+}
+
+// StreamOfflineEvent is sent for stream.offline subscriptions
+type StreamOfflineEvent struct {
+	BroadcasterUserID    string `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string `json:"broadcaster_user_login"`
+	BroadcasterUserName  string `json:"broadcaster_user_name"`
+}
+
+// UserUpdateEvent is sent for user.update subscriptions
+//
+// Email is only set if the app has the user:read:email scope for the user.
+type UserUpdateEvent struct {
+	UserID        string `json:"user_id"`
+	UserLogin     string `json:"user_login"`
+	UserName      string `json:"user_name"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Description   string `json:"description"`
+}
+
+// UserAuthorizationEvent is sent for user.authorization.grant and user.authorization.revoke subscriptions
+type UserAuthorizationEvent struct {
+	ClientID  string `json:"client_id"`
+	UserID    string `json:"user_id"`
+	UserLogin string `json:"user_login"`
+	UserName  string `json:"user_name"`
+}
+
+// UserWhisperMessageEvent is sent for user.whisper.message subscriptions
+type UserWhisperMessageEvent struct {
+	FromUserID    string `json:"from_user_id"`
+	FromUserLogin string `json:"from_user_login"`
+	FromUserName  string `json:"from_user_name"`
+	ToUserID      string `json:"to_user_id"`
+	ToUserLogin   string `json:"to_user_login"`
+	ToUserName    string `json:"to_user_name"`
+	WhisperID     string `json:"whisper_id"`
+	Whisper       struct {
+		Text string `json:"text"`
+	} `json:"whisper"`
+}
+
+// EventFactory returns a pointer to a new value that an event can be decoded into
+type EventFactory func() interface{}
+
+var events = map[string]EventFactory{}
+
+func init() {
+	register := func(factory EventFactory, version string, types ...string) {
+		for _, t := range types {
+			RegisterEvent(t, version, factory)
+		}
+	}
+	register(func() interface{} { return &ChannelUpdateEvent{} }, "2", "channel.update")
+	register(func() interface{} { return &ChannelFollowEvent{} }, "2", "channel.follow")
+	register(func() interface{} { return &ChannelAdBreakBeginEvent{} }, "1", "channel.ad_break.begin")
+	register(func() interface{} { return &ChannelChatClearEvent{} }, "1", "channel.chat.clear")
+	register(func() interface{} { return &ChannelChatClearUserMessagesEvent{} }, "1", "channel.chat.clear_user_messages")
+	register(func() interface{} { return &ChannelChatMessageEvent{} }, "1", "channel.chat.message")
+	register(func() interface{} { return &ChannelChatMessageDeleteEvent{} }, "1", "channel.chat.message_delete")
+	register(func() interface{} { return &ChannelChatNotificationEvent{} }, "1", "channel.chat.notification")
+	register(func() interface{} { return &ChannelChatSettingsUpdateEvent{} }, "1", "channel.chat_settings.update")
+	register(func() interface{} { return &ChannelSubscribeEvent{} }, "1", "channel.subscribe", "channel.subscription.end")
+	register(func() interface{} { return &ChannelSubscriptionGiftEvent{} }, "1", "channel.subscription.gift")
+	register(func() interface{} { return &ChannelSubscriptionMessageEvent{} }, "1", "channel.subscription.message")
+	register(func() interface{} { return &ChannelCheerEvent{} }, "1", "channel.cheer")
+	register(func() interface{} { return &ChannelRaidEvent{} }, "1", "channel.raid")
+	register(func() interface{} { return &ChannelBanEvent{} }, "1", "channel.ban")
+	register(func() interface{} { return &ChannelUnbanEvent{} }, "1", "channel.unban")
+	register(func() interface{} { return &ChannelRoleEvent{} }, "1", "channel.moderator.add", "channel.moderator.remove", "channel.vip.add", "channel.vip.remove")
+	register(func() interface{} { return &ChannelPointsCustomRewardEvent{} }, "1",
+		"channel.channel_points_custom_reward.add",
+		"channel.channel_points_custom_reward.update",
+		"channel.channel_points_custom_reward.remove",
+	)
+	register(func() interface{} { return &ChannelPointsCustomRewardRedemptionEvent{} }, "1",
+		"channel.channel_points_custom_reward_redemption.add",
+		"channel.channel_points_custom_reward_redemption.update",
+	)
+	register(func() interface{} { return &ChannelPollEvent{} }, "1", "channel.poll.begin", "channel.poll.progress", "channel.poll.end")
+	register(func() interface{} { return &ChannelPredictionEvent{} }, "1",
+		"channel.prediction.begin",
+		"channel.prediction.progress",
+		"channel.prediction.lock",
+		"channel.prediction.end",
+	)
+	register(func() interface{} { return &ChannelHypeTrainEvent{} }, "1", "channel.hype_train.begin", "channel.hype_train.progress", "channel.hype_train.end")
+	register(func() interface{} { return &ChannelGoalEvent{} }, "1", "channel.goal.begin", "channel.goal.progress", "channel.goal.end")
+	register(func() interface{} { return &ChannelCharityDonationEvent{} }, "1", "channel.charity_campaign.donate")
+	register(func() interface{} { return &ChannelCharityCampaignEvent{} }, "1",
+		"channel.charity_campaign.start",
+		"channel.charity_campaign.progress",
+		"channel.charity_campaign.stop",
+	)
+	register(func() interface{} { return &ChannelShieldModeEvent{} }, "1", "channel.shield_mode.begin", "channel.shield_mode.end")
+	register(func() interface{} { return &ChannelShoutoutCreateEvent{} }, "1", "channel.shoutout.create")
+	register(func() interface{} { return &ChannelShoutoutReceiveEvent{} }, "1", "channel.shoutout.receive")
+	register(func() interface{} { return &StreamOnlineEvent{} }, "1", "stream.online")
+	register(func() interface{} { return &StreamOfflineEvent{} }, "1", "stream.offline")
+	register(func() interface{} { return &UserUpdateEvent{} }, "1", "user.update")
+	register(func() interface{} { return &UserAuthorizationEvent{} }, "1", "user.authorization.grant", "user.authorization.revoke")
+	register(func() interface{} { return &UserWhisperMessageEvent{} }, "1", "user.whisper.message")
+}
+
+// RegisterEvent sets the type an event will be decoded into for the provided subscription type and version
+//
+// This can be used to decode subscription types that are not yet supported or to replace the default types.
+// It is not safe to call this while events are being decoded.
+func RegisterEvent(subscriptionType, version string, factory EventFactory) {
+	events[eventKey(subscriptionType, version)] = factory
+}
+
+// DecodeEvent decodes the data for an event into the type registered for the provided subscription type and version
+//
+// The returned value is always a pointer, such as *ChannelFollowEvent for channel.follow version 2.
+func DecodeEvent(subscriptionType, version string, data []byte) (interface{}, error) {
+	factory, ok := events[eventKey(subscriptionType, version)]
+	if !ok {
+		return nil, ErrUnknownEvent
+	}
+	event := factory()
+	if err := json.Unmarshal(data, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// Decode decodes the event into the type registered for the subscription type and version
+//
+//	event, err := notification.Decode()
+//	if follow, ok := event.(*eventsub.ChannelFollowEvent); ok {
+//		fmt.Printf("%s followed %s\n", follow.UserName, follow.BroadcasterUserName)
+//	}
+func (n Notification) Decode() (interface{}, error) {
+	return DecodeEvent(n.Subscription.Type, n.Subscription.Version, n.Event)
+}
+
+func eventKey(subscriptionType, version string) string {
+	return subscriptionType + "@" + version
+}
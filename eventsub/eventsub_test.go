@@ -0,0 +1,762 @@
+package eventsub
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/adeithe/go-twitch/api"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+var upgrader = websocket.Upgrader{}
+
+func newTestServer(t *testing.T, handler func(*websocket.Conn)) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		socket, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer socket.Close()
+		handler(socket)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func toWebSocketURL(url string) string {
+	return "ws" + strings.TrimPrefix(url, "http")
+}
+
+func writeMessage(t *testing.T, socket *websocket.Conn, msgType MessageType, payload string) {
+	msg := fmt.Sprintf(`{"metadata":{"message_id":"%d","message_type":"%s","message_timestamp":"2023-07-19T14:56:51.634234626Z"},"payload":%s}`, time.Now().UnixNano(), msgType, payload)
+	if err := socket.WriteMessage(websocket.TextMessage, []byte(msg)); err != nil {
+		t.Error(err)
+	}
+}
+
+func welcomePayload(id, reconnectURL string) string {
+	url := "null"
+	if len(reconnectURL) > 0 {
+		url = fmt.Sprintf("%q", reconnectURL)
+	}
+	return fmt.Sprintf(`{"session":{"id":"%s","status":"connected","connected_at":"2023-07-19T14:56:51.616329898Z","keepalive_timeout_seconds":10,"reconnect_url":%s}}`, id, url)
+}
+
+func waitForClose(socket *websocket.Conn) {
+	for {
+		if _, _, err := socket.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
+
+func TestSessionLifecycle(t *testing.T) {
+	server := newTestServer(t, func(socket *websocket.Conn) {
+		writeMessage(t, socket, WelcomeMessage, welcomePayload("session-1", ""))
+		writeMessage(t, socket, KeepaliveMessage, `{}`)
+		writeMessage(t, socket, NotificationMessage, `{"subscription":{"id":"sub-1","status":"enabled","type":"channel.follow","version":"2","cost":0,"condition":{"broadcaster_user_id":"1337"},"transport":{"method":"websocket","session_id":"session-1"},"created_at":"2023-07-19T14:56:51.616329898Z"},"event":{"user_id":"1234"}}`)
+		writeMessage(t, socket, RevocationMessage, `{"subscription":{"id":"sub-1","status":"authorization_revoked","type":"channel.follow","version":"2","cost":0,"condition":{"broadcaster_user_id":"1337"},"transport":{"method":"websocket","session_id":"session-1"},"created_at":"2023-07-19T14:56:51.616329898Z"}}`)
+		waitForClose(socket)
+	})
+
+	notifications := make(chan Notification, 1)
+	revocations := make(chan Subscription, 1)
+	disconnected := make(chan bool, 1)
+
+	conn := &Conn{}
+	if err := conn.SetURL(toWebSocketURL(server.URL)); err != nil {
+		t.Fatal(err)
+	}
+	conn.OnNotification(func(n Notification) {
+		notifications <- n
+	})
+	conn.OnRevocation(func(s Subscription) {
+		revocations <- s
+	})
+	conn.OnDisconnect(func() {
+		disconnected <- true
+	})
+	if err := conn.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, conn.IsConnected())
+	assert.Equal(t, "session-1", conn.SessionID())
+	assert.Equal(t, 10, conn.Session().KeepaliveTimeoutSeconds)
+	assert.Equal(t, "session-1", *conn.Transport().SessionID)
+	assert.ErrorIs(t, conn.SetURL(URL), ErrAlreadyConnected)
+	assert.ErrorIs(t, conn.Connect(), ErrAlreadyConnected)
+
+	select {
+	case n := <-notifications:
+		assert.Equal(t, NotificationMessage, n.Metadata.Type)
+		assert.Equal(t, "channel.follow", n.Subscription.Type)
+		assert.Equal(t, "1337", n.Subscription.Condition["broadcaster_user_id"])
+		assert.JSONEq(t, `{"user_id":"1234"}`, string(n.Event))
+	case <-time.After(time.Second * 5):
+		t.Fatal("timed out waiting for notification")
+	}
+
+	select {
+	case s := <-revocations:
+		assert.Equal(t, "authorization_revoked", s.Status)
+	case <-time.After(time.Second * 5):
+		t.Fatal("timed out waiting for revocation")
+	}
+
+	conn.Close()
+	assert.False(t, conn.IsConnected())
+	select {
+	case <-disconnected:
+	case <-time.After(time.Second * 5):
+		t.Fatal("timed out waiting for disconnect")
+	}
+}
+
+func TestSessionReconnect(t *testing.T) {
+	oldClosed := make(chan bool, 1)
+	target := newTestServer(t, func(socket *websocket.Conn) {
+		writeMessage(t, socket, WelcomeMessage, welcomePayload("session-1", ""))
+		writeMessage(t, socket, NotificationMessage, `{"subscription":{"id":"sub-1","type":"stream.online","version":"1"},"event":{}}`)
+		waitForClose(socket)
+	})
+	server := newTestServer(t, func(socket *websocket.Conn) {
+		writeMessage(t, socket, WelcomeMessage, welcomePayload("session-1", ""))
+		writeMessage(t, socket, ReconnectMessage, welcomePayload("session-1", toWebSocketURL(target.URL)))
+		for {
+			if _, _, err := socket.ReadMessage(); err != nil {
+				oldClosed <- websocket.IsCloseError(err, websocket.CloseNormalClosure)
+				return
+			}
+		}
+	})
+
+	reconnected := make(chan bool, 1)
+	notifications := make(chan Notification, 1)
+	disconnected := make(chan bool, 1)
+
+	conn := &Conn{}
+	conn.SetURL(toWebSocketURL(server.URL))
+	conn.OnReconnect(func() {
+		reconnected <- true
+	})
+	conn.OnNotification(func(n Notification) {
+		notifications <- n
+	})
+	conn.OnDisconnect(func() {
+		disconnected <- true
+	})
+	if err := conn.Connect(); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-reconnected:
+	case <-time.After(time.Second * 5):
+		t.Fatal("timed out waiting for reconnect")
+	}
+
+	select {
+	case n := <-notifications:
+		assert.Equal(t, "stream.online", n.Subscription.Type)
+	case <-time.After(time.Second * 5):
+		t.Fatal("timed out waiting for notification on new connection")
+	}
+
+	select {
+	case normal := <-oldClosed:
+		assert.True(t, normal, "old socket was not closed by the client")
+	case <-time.After(time.Second * 5):
+		t.Fatal("timed out waiting for the old socket to be closed")
+	}
+
+	select {
+	case <-disconnected:
+		t.Fatal("connection should not disconnect after the old socket was closed")
+	case <-time.After(time.Millisecond * 200):
+	}
+	assert.True(t, conn.IsConnected())
+	assert.Equal(t, "session-1", conn.SessionID())
+	conn.Close()
+}
+
+func TestKeepaliveTimeout(t *testing.T) {
+	server := newTestServer(t, func(socket *websocket.Conn) {
+		writeMessage(t, socket, WelcomeMessage, `{"session":{"id":"session-1","status":"connected","keepalive_timeout_seconds":1}}`)
+		waitForClose(socket)
+	})
+
+	disconnected := make(chan bool, 1)
+	conn := &Conn{}
+	conn.SetURL(toWebSocketURL(server.URL))
+	conn.OnDisconnect(func() {
+		disconnected <- true
+	})
+	if err := conn.Connect(); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-disconnected:
+	case <-time.After(time.Second * 5):
+		t.Fatal("connection was not closed after missing keepalive messages")
+	}
+	assert.False(t, conn.IsConnected())
+}
+
+func TestMissingWelcome(t *testing.T) {
+	server := newTestServer(t, func(socket *websocket.Conn) {
+		writeMessage(t, socket, KeepaliveMessage, `{}`)
+		waitForClose(socket)
+	})
+
+	conn := &Conn{}
+	conn.SetURL(toWebSocketURL(server.URL))
+	assert.ErrorIs(t, conn.Connect(), ErrNoWelcome)
+	assert.False(t, conn.IsConnected())
+}
+
+func TestDecodeEvent(t *testing.T) {
+	tests := []struct {
+		Type     string
+		Version  string
+		Event    string
+		Expected interface{}
+	}{
+		{"channel.follow", "2", `{"user_id":"1234","user_login":"cool_user","user_name":"Cool_User","broadcaster_user_id":"1337","broadcaster_user_login":"cooler_user","broadcaster_user_name":"Cooler_User","followed_at":"2020-07-15T18:16:11.17106713Z"}`, &ChannelFollowEvent{
+			UserID:               "1234",
+			UserLogin:            "cool_user",
+			UserName:             "Cool_User",
+			BroadcasterUserID:    "1337",
+			BroadcasterUserLogin: "cooler_user",
+			BroadcasterUserName:  "Cooler_User",
+			FollowedAt:           time.Date(2020, 7, 15, 18, 16, 11, 171067130, time.UTC),
+		}},
+		{"channel.raid", "1", `{"from_broadcaster_user_id":"1234","to_broadcaster_user_id":"1337","viewers":9001}`, &ChannelRaidEvent{
+			FromBroadcasterUserID: "1234",
+			ToBroadcasterUserID:   "1337",
+			Viewers:               9001,
+		}},
+		{"channel.subscription.end", "1", `{"user_id":"1234","tier":"1000","is_gift":true}`, &ChannelSubscribeEvent{
+			UserID: "1234",
+			Tier:   "1000",
+			IsGift: true,
+		}},
+		{"channel.cheer", "1", `{"is_anonymous":true,"user_id":null,"bits":1000}`, &ChannelCheerEvent{
+			IsAnonymous: true,
+			Bits:        1000,
+		}},
+		{"stream.offline", "1", `{"broadcaster_user_id":"1337"}`, &StreamOfflineEvent{
+			BroadcasterUserID: "1337",
+		}},
+	}
+
+	for _, tt := range tests {
+		actual, err := Notification{
+			Subscription: Subscription{Type: tt.Type, Version: tt.Version},
+			Event:        []byte(tt.Event),
+		}.Decode()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tt.Expected, actual)
+	}
+
+	_, err := DecodeEvent("channel.follow", "1", []byte(`{}`))
+	assert.ErrorIs(t, err, ErrUnknownEvent)
+
+	type customEvent struct {
+		Value string `json:"value"`
+	}
+	RegisterEvent("custom.event", "beta", func() interface{} { return &customEvent{} })
+	actual, err := DecodeEvent("custom.event", "beta", []byte(`{"value":"test"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, &customEvent{Value: "test"}, actual)
+}
+
+func TestDecodeChatMessage(t *testing.T) {
+	data := `{"broadcaster_user_id":"1971641","chatter_user_id":"4145994","chatter_user_login":"viptest","message_id":"cc106a89-1814-919d-454c-f4f2f970aae7","message":{"text":"Hi chat Kappa","fragments":[{"type":"text","text":"Hi chat ","cheermote":null,"emote":null,"mention":null},{"type":"emote","text":"Kappa","cheermote":null,"emote":{"id":"25","emote_set_id":"0","owner_id":"0","format":["static"]},"mention":null}]},"color":"#00FF7F","badges":[{"set_id":"vip","id":"1","info":""}],"message_type":"text","cheer":null,"reply":null,"channel_points_custom_reward_id":null}`
+	event, err := DecodeEvent("channel.chat.message", "1", []byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg, ok := event.(*ChannelChatMessageEvent)
+	if !ok {
+		t.Fatalf("unexpected event type %T", event)
+	}
+	assert.Equal(t, "Hi chat Kappa", msg.Message.Text)
+	assert.Len(t, msg.Message.Fragments, 2)
+	assert.Nil(t, msg.Message.Fragments[0].Emote)
+	assert.Equal(t, "25", msg.Message.Fragments[1].Emote.ID)
+	assert.Equal(t, "vip", msg.Badges[0].SetID)
+	assert.Nil(t, msg.Reply)
+	assert.Nil(t, msg.Cheer)
+}
+
+func newWebhookRequest(secret string, msgType MessageType, id string, timestamp time.Time, body string) *http.Request {
+	ts := timestamp.Format(time.RFC3339Nano)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(id + ts + body))
+
+	req := httptest.NewRequest(http.MethodPost, "/eventsub", strings.NewReader(body))
+	req.Header.Set(HeaderMessageID, id)
+	req.Header.Set(HeaderMessageType, string(msgType))
+	req.Header.Set(HeaderMessageTimestamp, ts)
+	req.Header.Set(HeaderMessageSignature, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	req.Header.Set(HeaderSubscriptionType, "channel.follow")
+	req.Header.Set(HeaderSubscriptionVersion, "2")
+	return req
+}
+
+func TestWebhookHandler(t *testing.T) {
+	secret := "s3cRe7s3cRe7"
+	subscription := `{"id":"sub-1","status":"enabled","type":"channel.follow","version":"2","cost":0,"condition":{"broadcaster_user_id":"1337"},"transport":{"method":"webhook","callback":"https://example.com/eventsub"},"created_at":"2019-11-16T10:11:12.634234626Z"}`
+
+	notifications := make(chan Notification, 1)
+	revocations := make(chan Subscription, 1)
+	verifications := make(chan Subscription, 1)
+
+	handler := NewWebhookHandler(secret)
+	handler.OnNotification(func(n Notification) {
+		notifications <- n
+	})
+	handler.OnRevocation(func(s Subscription) {
+		revocations <- s
+	})
+	handler.OnVerification(func(s Subscription) {
+		verifications <- s
+	})
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, newWebhookRequest(secret, VerificationMessage, "msg-1", time.Now(), `{"challenge":"pogchamp-kappa-360noscope-vohiyo","subscription":`+subscription+`}`))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "pogchamp-kappa-360noscope-vohiyo", w.Body.String())
+	select {
+	case s := <-verifications:
+		assert.Equal(t, "sub-1", s.ID)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for verification")
+	}
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, newWebhookRequest(secret, NotificationMessage, "msg-2", time.Now(), `{"subscription":`+subscription+`,"event":{"user_id":"1234","broadcaster_user_id":"1337"}}`))
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	select {
+	case n := <-notifications:
+		assert.Equal(t, "msg-2", n.Metadata.ID)
+		assert.Equal(t, "channel.follow", n.Metadata.SubscriptionType)
+		event, err := n.Decode()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "1234", event.(*ChannelFollowEvent).UserID)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for notification")
+	}
+
+	// A duplicate message should be acknowledged but not dispatched.
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, newWebhookRequest(secret, NotificationMessage, "msg-2", time.Now(), `{"subscription":`+subscription+`,"event":{}}`))
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	select {
+	case <-notifications:
+		t.Fatal("duplicate notification was dispatched")
+	case <-time.After(time.Millisecond * 100):
+	}
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, newWebhookRequest(secret, RevocationMessage, "msg-3", time.Now(), `{"subscription":`+subscription+`}`))
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	select {
+	case s := <-revocations:
+		assert.Equal(t, "sub-1", s.ID)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for revocation")
+	}
+}
+
+func TestWebhookHandlerRejections(t *testing.T) {
+	handler := NewWebhookHandler("s3cRe7s3cRe7")
+	handler.OnNotification(func(n Notification) {
+		t.Error("rejected notification was dispatched")
+	})
+
+	tests := []struct {
+		Request  *http.Request
+		Expected int
+	}{
+		{newWebhookRequest("wrong-secret", NotificationMessage, "msg-1", time.Now(), `{}`), http.StatusForbidden},
+		{newWebhookRequest("s3cRe7s3cRe7", NotificationMessage, "msg-2", time.Now().Add(-time.Minute*11), `{}`), http.StatusForbidden},
+		{httptest.NewRequest(http.MethodPost, "/eventsub", strings.NewReader(`{}`)), http.StatusForbidden},
+		{httptest.NewRequest(http.MethodGet, "/eventsub", nil), http.StatusMethodNotAllowed},
+	}
+
+	tampered := newWebhookRequest("s3cRe7s3cRe7", NotificationMessage, "msg-3", time.Now(), `{}`)
+	tampered.Body = io.NopCloser(strings.NewReader(`{"event":{}}`))
+	tests = append(tests, struct {
+		Request  *http.Request
+		Expected int
+	}{tampered, http.StatusForbidden})
+
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, tt.Request)
+		assert.Equal(t, tt.Expected, w.Code)
+	}
+	time.Sleep(time.Millisecond * 100)
+}
+
+func TestWebhookHandlerRetry(t *testing.T) {
+	secret := "s3cRe7s3cRe7"
+	notifications := make(chan Notification, 1)
+	handler := NewWebhookHandler(secret)
+	handler.OnNotification(func(n Notification) {
+		notifications <- n
+	})
+
+	// A message that fails to parse must not be marked as seen, so that the retry from Twitch is dispatched.
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, newWebhookRequest(secret, NotificationMessage, "msg-1", time.Now(), `{"event":`))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, newWebhookRequest(secret, NotificationMessage, "msg-1", time.Now(), `{"event":{}}`))
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	select {
+	case n := <-notifications:
+		assert.Equal(t, "msg-1", n.Metadata.ID)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for notification")
+	}
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, newWebhookRequest(secret, NotificationMessage, "msg-2", time.Now(), `{"event":"`+strings.Repeat("a", maxWebhookBodySize)+`"}`))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+}
+
+func TestWebhookHandlerSeenExpiry(t *testing.T) {
+	handler := NewWebhookHandler("s3cRe7s3cRe7")
+	handler.SetMaxMessageAge(time.Millisecond * 50)
+
+	assert.True(t, handler.markSeen(Metadata{ID: "msg-1"}))
+	time.Sleep(time.Millisecond * 60)
+	// Rotated once, the ID is still remembered for at least the max age.
+	assert.False(t, handler.markSeen(Metadata{ID: "msg-1"}))
+	time.Sleep(time.Millisecond * 60)
+	assert.True(t, handler.markSeen(Metadata{ID: "msg-2"}))
+	assert.True(t, handler.markSeen(Metadata{ID: "msg-1"}))
+}
+
+type mockHTTPClient func(*http.Request) (*http.Response, error)
+
+func (f mockHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func newMockResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     make(http.Header),
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestConduitShards(t *testing.T) {
+	var sessions int32
+	sockets := make(chan *websocket.Conn, 10)
+	server := newTestServer(t, func(socket *websocket.Conn) {
+		id := atomic.AddInt32(&sessions, 1)
+		writeMessage(t, socket, WelcomeMessage, welcomePayload(fmt.Sprintf("session-%d", id), ""))
+		sockets <- socket
+		waitForClose(socket)
+	})
+
+	var listed int32
+	assignments := make(chan map[string]string, 10)
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/helix/eventsub/conduits":
+			return newMockResponse(http.StatusOK, `{"data":[{"id":"conduit-1","shard_count":2}]}`), nil
+		case req.Method == http.MethodPatch && req.URL.Path == "/helix/eventsub/conduits/shards":
+			var body struct {
+				ConduitID string             `json:"conduit_id"`
+				Shards    []api.ConduitShard `json:"shards"`
+			}
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Error(err)
+			}
+			assert.Equal(t, "conduit-1", body.ConduitID)
+			assigned := make(map[string]string)
+			for _, shard := range body.Shards {
+				assigned[shard.ID] = *shard.Transport.SessionID
+			}
+			assignments <- assigned
+			return newMockResponse(http.StatusAccepted, `{"data":[]}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/helix/eventsub/conduits/shards":
+			assert.Equal(t, "conduit-1", req.URL.Query().Get("conduit_id"))
+			status := "enabled"
+			if atomic.AddInt32(&listed, 1) == 1 {
+				status = "websocket_disconnected"
+			}
+			return newMockResponse(http.StatusOK, `{"data":[{"id":"0","status":"enabled"},{"id":"1","status":"`+status+`"}]}`), nil
+		}
+		t.Errorf("unexpected request: %s %s", req.Method, req.URL)
+		return newMockResponse(http.StatusNotFound, `{}`), nil
+	})))
+
+	waitForAssignment := func() map[string]string {
+		select {
+		case assigned := <-assignments:
+			return assigned
+		case <-time.After(time.Second * 5):
+			t.Fatal("timed out waiting for shard assignment")
+		}
+		return nil
+	}
+
+	conduit := NewConduit(client)
+	conduit.SetURL(toWebSocketURL(server.URL))
+	conduit.SetShardCount(2)
+	conduit.SetHealthCheckInterval(time.Millisecond * 200)
+	if err := conduit.Start(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	defer conduit.Close()
+
+	assert.Equal(t, "conduit-1", conduit.ID())
+	assert.Equal(t, 2, conduit.GetNumShards())
+	assigned := waitForAssignment()
+	assert.Len(t, assigned, 2)
+	for id, session := range assigned {
+		shard, _ := strconv.Atoi(id)
+		conn, ok := conduit.GetShard(shard)
+		assert.True(t, ok)
+		assert.Equal(t, conn.SessionID(), session)
+	}
+
+	// The API reported shard 1 as disconnected during the first health check.
+	assert.Equal(t, map[string]string{"1": assigned["1"]}, waitForAssignment())
+
+	// Dropping a connection should open a new session and assign it to the same shard.
+	(<-sockets).Close()
+	reassigned := waitForAssignment()
+	assert.Len(t, reassigned, 1)
+	assert.Equal(t, "session-3", reassigned["0"]+reassigned["1"])
+}
+
+func TestConduitRestart(t *testing.T) {
+	server := newTestServer(t, func(socket *websocket.Conn) {
+		writeMessage(t, socket, WelcomeMessage, welcomePayload("session", ""))
+		waitForClose(socket)
+	})
+
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.URL.Path == "/helix/eventsub/conduits":
+			return newMockResponse(http.StatusOK, `{"data":[{"id":"conduit-1","shard_count":1}]}`), nil
+		case req.Method == http.MethodPatch && req.URL.Path == "/helix/eventsub/conduits/shards":
+			return newMockResponse(http.StatusAccepted, `{"data":[]}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/helix/eventsub/conduits/shards":
+			return newMockResponse(http.StatusOK, `{"data":[{"id":"0","status":"enabled"}]}`), nil
+		}
+		t.Errorf("unexpected request: %s %s", req.Method, req.URL)
+		return newMockResponse(http.StatusNotFound, `{}`), nil
+	})))
+
+	conduit := NewConduit(client)
+	conduit.SetURL(toWebSocketURL(server.URL))
+	conduit.SetHealthCheckInterval(time.Millisecond * 50)
+	if err := conduit.Start(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	// Give the first health check time to run before starting again.
+	time.Sleep(time.Millisecond * 100)
+	if err := conduit.Start(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, conduit.GetNumShards())
+
+	closed := make(chan struct{})
+	go func() {
+		conduit.Close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(time.Second * 5):
+		t.Fatal("timed out waiting for the conduit to close")
+	}
+}
+
+func TestConduitClosed(t *testing.T) {
+	conduit := NewConduit(api.New("client-id"))
+	conduit.Close()
+	_, err := conduit.connect(0)
+	assert.Equal(t, ErrConduitClosed, err)
+}
+
+func TestConduitAssignErrors(t *testing.T) {
+	server := newTestServer(t, func(socket *websocket.Conn) {
+		writeMessage(t, socket, WelcomeMessage, welcomePayload("session", ""))
+		waitForClose(socket)
+	})
+
+	client := api.New("client-id", api.WithHTTPClient(mockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.URL.Path == "/helix/eventsub/conduits":
+			return newMockResponse(http.StatusOK, `{"data":[{"id":"conduit-1","shard_count":2}]}`), nil
+		case req.Method == http.MethodPatch && req.URL.Path == "/helix/eventsub/conduits/shards":
+			return newMockResponse(http.StatusAccepted, `{"data":[{"id":"0","status":"enabled"}],"errors":[{"id":"1","message":"The websocket session is not connected","code":"websocket_not_connected"}]}`), nil
+		}
+		t.Errorf("unexpected request: %s %s", req.Method, req.URL)
+		return newMockResponse(http.StatusNotFound, `{}`), nil
+	})))
+
+	assigned := make(chan int, 2)
+	conduit := NewConduit(client)
+	conduit.SetURL(toWebSocketURL(server.URL))
+	conduit.SetShardCount(2)
+	conduit.OnShardAssign(func(id int, _ string) {
+		assigned <- id
+	})
+	err := conduit.Start(context.Background())
+	var assignErr *AssignError
+	if assert.ErrorAs(t, err, &assignErr) {
+		assert.Equal(t, "1", assignErr.Shards[0].ID)
+		assert.Equal(t, "websocket_not_connected", assignErr.Shards[0].Code)
+	}
+
+	select {
+	case id := <-assigned:
+		assert.Equal(t, 0, id)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for shard assignment")
+	}
+	select {
+	case id := <-assigned:
+		t.Errorf("shard %d was reported as assigned", id)
+	case <-time.After(time.Millisecond * 100):
+	}
+}
+
+func TestCloseDuringReconnect(t *testing.T) {
+	dialed := make(chan bool, 1)
+	target := newTestServer(t, func(socket *websocket.Conn) {
+		dialed <- true
+		time.Sleep(time.Millisecond * 500)
+		writeMessage(t, socket, WelcomeMessage, welcomePayload("session-1", ""))
+		writeMessage(t, socket, NotificationMessage, `{"subscription":{"id":"sub-1","type":"stream.online","version":"1"},"event":{}}`)
+		waitForClose(socket)
+	})
+	server := newTestServer(t, func(socket *websocket.Conn) {
+		writeMessage(t, socket, WelcomeMessage, welcomePayload("session-1", ""))
+		writeMessage(t, socket, ReconnectMessage, welcomePayload("session-1", toWebSocketURL(target.URL)))
+		waitForClose(socket)
+	})
+
+	var reconnects, notifications int32
+	conn := &Conn{}
+	conn.SetURL(toWebSocketURL(server.URL))
+	conn.OnReconnect(func() {
+		atomic.AddInt32(&reconnects, 1)
+	})
+	conn.OnNotification(func(Notification) {
+		atomic.AddInt32(&notifications, 1)
+	})
+	if err := conn.Connect(); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-dialed:
+	case <-time.After(time.Second * 5):
+		t.Fatal("timed out waiting for reconnect")
+	}
+	closed := make(chan bool)
+	go func() {
+		conn.Close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(time.Second * 5):
+		t.Fatal("timed out waiting for the connection to close")
+	}
+
+	time.Sleep(time.Millisecond * 700)
+	assert.False(t, conn.IsConnected())
+	assert.Equal(t, int32(0), atomic.LoadInt32(&reconnects))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&notifications))
+}
+
+func TestConcurrentConnect(t *testing.T) {
+	var sessions int32
+	server := newTestServer(t, func(socket *websocket.Conn) {
+		atomic.AddInt32(&sessions, 1)
+		time.Sleep(time.Millisecond * 100)
+		writeMessage(t, socket, WelcomeMessage, welcomePayload("session-1", ""))
+		waitForClose(socket)
+	})
+
+	conn := &Conn{}
+	conn.SetURL(toWebSocketURL(server.URL))
+	errs := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			errs <- conn.Connect()
+		}()
+	}
+	var connected int
+	for i := 0; i < 2; i++ {
+		if err := <-errs; err == nil {
+			connected++
+		} else {
+			assert.ErrorIs(t, err, ErrAlreadyConnected)
+		}
+	}
+	assert.Equal(t, 1, connected)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&sessions))
+	conn.Close()
+}
+
+func TestConnectAfterClose(t *testing.T) {
+	var sessions int32
+	server := newTestServer(t, func(socket *websocket.Conn) {
+		atomic.AddInt32(&sessions, 1)
+		writeMessage(t, socket, WelcomeMessage, welcomePayload("session-1", ""))
+		waitForClose(socket)
+	})
+
+	conn := &Conn{}
+	conn.SetURL(toWebSocketURL(server.URL))
+	if err := conn.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	conn.Close()
+	assert.ErrorIs(t, conn.Connect(), ErrNotConnected)
+	assert.False(t, conn.IsConnected())
+	assert.Equal(t, int32(1), atomic.LoadInt32(&sessions))
+
+	// Reconnect opens a closed connection again.
+	if err := conn.Reconnect(); err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, conn.IsConnected())
+	assert.Equal(t, int32(2), atomic.LoadInt32(&sessions))
+	conn.Close()
+}
@@ -0,0 +1,99 @@
+package eventsub
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/adeithe/go-twitch/api"
+)
+
+var (
+	// ErrAlreadyConnected returned when a connection tries to connect but is already running
+	ErrAlreadyConnected = errors.New("connection is already open")
+	// ErrNotConnected returned when the connection is closed
+	ErrNotConnected = errors.New("connection is closed")
+	// ErrNoWelcome returned when the server does not start a session with a welcome message
+	ErrNoWelcome = errors.New("server did not send a welcome message")
+	// ErrNoConduit returned when the API does not return the conduit being managed
+	ErrNoConduit = errors.New("conduit was not found")
+	// ErrConduitClosed returned when a shard is opened after the conduit has been closed
+	ErrConduitClosed = errors.New("conduit is closed")
+	// ErrUnknownEvent returned when no event type is registered for a subscription type and version
+	ErrUnknownEvent = errors.New("unknown subscription type or version")
+)
+
+// AssignError returned when the API rejects the sessions of one or more shards
+type AssignError struct {
+	Shards []api.ConduitShardError
+}
+
+func (err *AssignError) Error() string {
+	shard := err.Shards[0]
+	if len(err.Shards) == 1 {
+		return fmt.Sprintf("shard %s could not be assigned: %s", shard.ID, shard.Message)
+	}
+	return fmt.Sprintf("%d shards could not be assigned, including shard %s: %s", len(err.Shards), shard.ID, shard.Message)
+}
+
+// Packet stores data about a message sent from the EventSub server
+type Packet struct {
+	Metadata Metadata        `json:"metadata"`
+	Payload  json.RawMessage `json:"payload"`
+}
+
+// Metadata stores data that describes a message sent from the EventSub server
+type Metadata struct {
+	ID                  string      `json:"message_id"`
+	Type                MessageType `json:"message_type"`
+	Timestamp           time.Time   `json:"message_timestamp"`
+	SubscriptionType    string      `json:"subscription_type,omitempty"`
+	SubscriptionVersion string      `json:"subscription_version,omitempty"`
+}
+
+// Session stores data about an EventSub WebSocket session
+type Session struct {
+	ID                      string    `json:"id"`
+	Status                  string    `json:"status"`
+	KeepaliveTimeoutSeconds int       `json:"keepalive_timeout_seconds"`
+	ReconnectURL            string    `json:"reconnect_url"`
+	ConnectedAt             time.Time `json:"connected_at"`
+}
+
+// Subscription stores data about an EventSub subscription
+type Subscription struct {
+	ID        string            `json:"id"`
+	Status    string            `json:"status"`
+	Type      string            `json:"type"`
+	Version   string            `json:"version"`
+	Cost      int               `json:"cost"`
+	Condition map[string]string `json:"condition"`
+	Transport api.Transport     `json:"transport"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+// Notification stores data about an event sent for a subscription
+type Notification struct {
+	Metadata     Metadata        `json:"-"`
+	Subscription Subscription    `json:"subscription"`
+	Event        json.RawMessage `json:"event"`
+}
+
+// MessageType stores the type provided in Metadata
+type MessageType string
+
+const (
+	// WelcomeMessage incoming message type sent after connecting
+	WelcomeMessage MessageType = "session_welcome"
+	// KeepaliveMessage incoming message type sent when no events have been sent recently
+	KeepaliveMessage MessageType = "session_keepalive"
+	// ReconnectMessage incoming message type sent before the server closes the connection
+	ReconnectMessage MessageType = "session_reconnect"
+	// NotificationMessage incoming message type sent when a subscribed event occurs
+	NotificationMessage MessageType = "notification"
+	// RevocationMessage incoming message type sent when a subscription is revoked
+	RevocationMessage MessageType = "revocation"
+	// VerificationMessage incoming message type sent to a webhook after a subscription is created
+	VerificationMessage MessageType = "webhook_callback_verification"
+)
@@ -0,0 +1,238 @@
+package eventsub
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Headers sent by Twitch with every webhook request
+const (
+	HeaderMessageID           = "Twitch-Eventsub-Message-Id"
+	HeaderMessageRetry        = "Twitch-Eventsub-Message-Retry"
+	HeaderMessageType         = "Twitch-Eventsub-Message-Type"
+	HeaderMessageSignature    = "Twitch-Eventsub-Message-Signature"
+	HeaderMessageTimestamp    = "Twitch-Eventsub-Message-Timestamp"
+	HeaderSubscriptionType    = "Twitch-Eventsub-Subscription-Type"
+	HeaderSubscriptionVersion = "Twitch-Eventsub-Subscription-Version"
+)
+
+// maxWebhookBodySize is the largest request body the webhook handler will read
+const maxWebhookBodySize = 1 << 20
+
+// defaultMaxMessageAge is how old a webhook message may be by default before it is rejected
+const defaultMaxMessageAge = time.Minute * 10
+
+// WebhookHandler is an http.Handler that receives messages sent to an EventSub webhook callback
+type WebhookHandler struct {
+	secret []byte
+	maxAge time.Duration
+
+	// Message IDs are kept in two generations that are rotated every max age, so that an ID is remembered for at
+	// least as long as its message would be accepted without scanning every ID.
+	seen    map[string]struct{}
+	expired map[string]struct{}
+	rotated time.Time
+
+	onVerification []func(Subscription)
+	onNotification []func(Notification)
+	onRevocation   []func(Subscription)
+
+	mx sync.Mutex
+}
+
+// IWebhookHandler interface for methods used by the EventSub webhook handler
+type IWebhookHandler interface {
+	http.Handler
+	SetMaxMessageAge(time.Duration)
+
+	OnVerification(func(Subscription))
+	OnNotification(func(Notification))
+	OnRevocation(func(Subscription))
+}
+
+var _ IWebhookHandler = &WebhookHandler{}
+
+// NewWebhookHandler creates a handler for webhook messages signed with the provided secret
+//
+// The secret must be the same one passed to api.NewWebhookTransport when creating the subscriptions.
+// Messages with an invalid signature or a timestamp older than 10 minutes are rejected, and messages
+// that have already been handled are acknowledged without being dispatched again.
+//
+// See: https://dev.twitch.tv/docs/eventsub/handling-webhook-events
+func NewWebhookHandler(secret string) *WebhookHandler {
+	return &WebhookHandler{
+		secret: []byte(secret),
+		maxAge: defaultMaxMessageAge,
+		seen:   make(map[string]struct{}),
+	}
+}
+
+// SetMaxMessageAge changes how old a message may be before it is rejected
+//
+// Default: 10 minutes
+func (h *WebhookHandler) SetMaxMessageAge(d time.Duration) {
+	if d <= 0 {
+		d = defaultMaxMessageAge
+	}
+	h.mx.Lock()
+	defer h.mx.Unlock()
+	h.maxAge = d
+}
+
+// OnVerification event called after a webhook_callback_verification challenge has been answered
+func (h *WebhookHandler) OnVerification(f func(Subscription)) {
+	h.onVerification = append(h.onVerification, f)
+}
+
+// OnNotification event called after an event is received for a subscription
+func (h *WebhookHandler) OnNotification(f func(Notification)) {
+	h.onNotification = append(h.onNotification, f)
+}
+
+// OnRevocation event called after a subscription has been revoked by Twitch
+func (h *WebhookHandler) OnRevocation(f func(Subscription)) {
+	h.onRevocation = append(h.onRevocation, f)
+}
+
+// ServeHTTP verifies and handles a message sent by Twitch
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBodySize+1))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if len(body) > maxWebhookBodySize {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		return
+	}
+	if !h.verifySignature(r.Header, body) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	metadata, err := parseWebhookMetadata(r.Header)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if !h.isRecent(metadata.Timestamp) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	var payload struct {
+		Challenge string `json:"challenge"`
+		Notification
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	// Messages are only marked as seen once they are parsed, so that Twitch can retry a message that failed.
+	if metadata.Type != VerificationMessage && !h.markSeen(metadata) {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	switch metadata.Type {
+	case VerificationMessage:
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(payload.Challenge))
+		for _, f := range h.onVerification {
+			go f(payload.Subscription)
+		}
+	case NotificationMessage:
+		w.WriteHeader(http.StatusNoContent)
+		notification := payload.Notification
+		notification.Metadata = metadata
+		for _, f := range h.onNotification {
+			go f(notification)
+		}
+	case RevocationMessage:
+		w.WriteHeader(http.StatusNoContent)
+		for _, f := range h.onRevocation {
+			go f(payload.Subscription)
+		}
+	default:
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// verifySignature compares the signature sent by Twitch with the HMAC-SHA256 of the message.
+func (h *WebhookHandler) verifySignature(header http.Header, body []byte) bool {
+	signature := header.Get(HeaderMessageSignature)
+	if !strings.HasPrefix(signature, "sha256=") {
+		return false
+	}
+	expected, err := hex.DecodeString(strings.TrimPrefix(signature, "sha256="))
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, h.secret)
+	mac.Write([]byte(header.Get(HeaderMessageID)))
+	mac.Write([]byte(header.Get(HeaderMessageTimestamp)))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+func (h *WebhookHandler) isRecent(t time.Time) bool {
+	maxAge := h.getMaxAge()
+	age := time.Since(t)
+	return age < maxAge && age > -maxAge
+}
+
+func (h *WebhookHandler) getMaxAge() time.Duration {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+	if h.maxAge <= 0 {
+		return defaultMaxMessageAge
+	}
+	return h.maxAge
+}
+
+// markSeen records the message ID and returns false if it has already been handled.
+func (h *WebhookHandler) markSeen(metadata Metadata) bool {
+	maxAge := h.getMaxAge()
+	h.mx.Lock()
+	defer h.mx.Unlock()
+	if h.seen == nil {
+		h.seen = make(map[string]struct{})
+	}
+	if now := time.Now(); now.Sub(h.rotated) > maxAge {
+		h.expired, h.seen = h.seen, make(map[string]struct{})
+		h.rotated = now
+	}
+	if _, ok := h.seen[metadata.ID]; ok {
+		return false
+	}
+	if _, ok := h.expired[metadata.ID]; ok {
+		return false
+	}
+	h.seen[metadata.ID] = struct{}{}
+	return true
+}
+
+func parseWebhookMetadata(header http.Header) (Metadata, error) {
+	timestamp, err := time.Parse(time.RFC3339Nano, header.Get(HeaderMessageTimestamp))
+	if err != nil {
+		return Metadata{}, err
+	}
+	return Metadata{
+		ID:                  header.Get(HeaderMessageID),
+		Type:                MessageType(header.Get(HeaderMessageType)),
+		Timestamp:           timestamp,
+		SubscriptionType:    header.Get(HeaderSubscriptionType),
+		SubscriptionVersion: header.Get(HeaderSubscriptionVersion),
+	}, nil
+}
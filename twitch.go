@@ -2,6 +2,7 @@ package twitch
 
 import (
 	"github.com/adeithe/go-twitch/api"
+	"github.com/adeithe/go-twitch/eventsub"
 	"github.com/adeithe/go-twitch/irc"
 	"github.com/adeithe/go-twitch/pubsub"
 )
@@ -11,6 +12,11 @@ func API(clientID string, opts ...api.ClientOption) *api.Client {
 	return api.New(clientID, opts...)
 }
 
+// EventSub receives events for subscriptions made with the API over a WebSocket connection.
+func EventSub() *eventsub.Conn {
+	return &eventsub.Conn{}
+}
+
 // IRC is the Twitch interface for chat functionality.
 func IRC() *irc.Client {
 	return irc.New()